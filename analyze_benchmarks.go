@@ -2,35 +2,176 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"html/template"
+	"math"
+	"math/rand"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 )
 
-// BenchmarkResult holds parsed benchmark data
+// deltaTest selects the significance test applied to per-benchmark
+// speedups when multiple iterations are available for a benchmark.
+// Valid values are "utest" (Mann-Whitney U), "ttest" (Welch's
+// t-test), and "none" (no significance filtering, the historical
+// behavior). Defaults to "none" so existing single-run test styles
+// report exactly as before unless a user opts in.
+var deltaTest = flag.String("delta-test", "none", "significance test for per-benchmark speedups: utest, ttest, or none")
+
+// significanceThreshold is the p-value above which a speedup is
+// reported as "~" (not statistically significant), matching the
+// semantics of Go's benchstat tool.
+const significanceThreshold = 0.05
+
+// systemSet is a repeatable -system name=directory flag describing
+// the set of systems to compare. When no -system flags are given,
+// main falls back to the tool's historical Debian/IYA/RHEL default
+// so existing invocations keep working unchanged.
+type systemSet map[string]string
+
+func (s systemSet) String() string {
+	parts := make([]string, 0, len(s))
+	for name, dir := range s {
+		parts = append(parts, name+"="+dir)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (s systemSet) Set(value string) error {
+	name, dir, ok := strings.Cut(value, "=")
+	if !ok {
+		return fmt.Errorf("expected name=directory, got %q", value)
+	}
+	s[name] = dir
+	return nil
+}
+
+var systems = make(systemSet)
+
+var baseline = flag.String("baseline", "", "system name used as the speedup baseline (defaults to Debian, or the first configured system)")
+
+// split names the Go benchmark header labels (e.g. "pkg", "goos",
+// "goarch", or any user-added "key: value" line such as "cpu" or
+// "commit") that must match for two benchmark runs to be analyzed
+// together. Input files that mix several packages or architectures
+// are split into one analysis group per distinct combination of
+// these label values instead of silently averaging them together.
+var split = flag.String("split", "pkg,goos,goarch", "comma-separated benchmark header labels to split analysis groups by")
+
+// bootstrapResamples is the number of resamples-with-replacement
+// used to compute each benchmark's 95% confidence interval on the
+// mean (see bootstrapCI). Set to 0 to skip CI computation entirely.
+var bootstrapResamples = flag.Int("bootstrap", 1000, "number of bootstrap resamples for 95% confidence intervals (0 disables)")
+
+// htmlOut, when non-empty, writes a self-contained HTML report
+// (sortable tables, inline SVG box-plots, and a summary section) to
+// the given path, for committing to a repo or serving to reviewers
+// without a markdown or CSV viewer.
+var htmlOut = flag.String("html", "", "write a self-contained HTML report to this path (disabled if empty)")
+
+func init() {
+	flag.Var(systems, "system", "a system to analyze, given as name=directory (repeat for more than one system)")
+}
+
+// benchLabelLine matches a Go benchmark header line such as
+// "pkg: example.com/foo", "goos: linux", or a user-added "cpu: ..."
+// line. Benchmark result lines never match since they start with
+// "Benchmark" and contain no colon before the first field.
+var benchLabelLine = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*):\s*(.+)$`)
+
+// parseLabelLine extracts the key/value pair from a benchmark
+// header line, reporting ok=false for lines that aren't one.
+func parseLabelLine(line string) (key, value string, ok bool) {
+	m := benchLabelLine.FindStringSubmatch(line)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], strings.TrimSpace(m[2]), true
+}
+
+// splitKeys parses the -split flag into a list of label names,
+// trimming whitespace and dropping empty entries.
+func splitKeys(value string) []string {
+	var keys []string
+	for _, k := range strings.Split(value, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// groupKeyFor builds the canonical, order-independent key identifying
+// the analysis group that labels belongs to, given the configured
+// split keys. Benchmarks with an empty groupKey (no split keys
+// configured, or none of them present in the input) form one group,
+// preserving the tool's historical unsplit behavior.
+func groupKeyFor(labels map[string]string, keys []string) string {
+	var parts []string
+	for _, k := range keys {
+		if v, ok := labels[k]; ok {
+			parts = append(parts, k+"="+v)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// copyLabels returns an independent copy of labels, so callers that
+// keep mutating their own label map (e.g. a parser tracking the
+// most recent header line) can't retroactively change labels
+// already handed out to earlier samples.
+func copyLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		out[k] = v
+	}
+	return out
+}
+
+// Metrics holds the raw ns/op, B/op, and allocs/op measurements for
+// one benchmark on one system, plus every ns/op sample observed
+// across however many runs a test style contains (a style with a
+// single run simply produces a one-element Samples slice).
+type Metrics struct {
+	NsOp        float64
+	BytesPerOp  float64
+	AllocsPerOp float64
+	Samples     []float64
+	// CILow and CIHigh bound the bootstrap 95% confidence interval
+	// on NsOp's mean (see bootstrapCI). Both are zero when there
+	// were too few samples to resample or -bootstrap was 0.
+	CILow  float64
+	CIHigh float64
+}
+
+// BenchmarkResult holds parsed benchmark data for one benchmark
+// across an arbitrary, configured set of systems.
 type BenchmarkResult struct {
-	Name            string
-	Metric          string
-	Debian          string
-	IYA             string
-	RHEL            string
-	BestPerformance string
-	IYAVsDebian     string
-	IYAVsRHEL       string
-	DebianValue     float64
-	IYAValue        float64
-	RHELValue       float64
-	// Additional metrics
-	DebianBytesPerOp  float64
-	IYABytesPerOp     float64
-	RHELBytesPerOp    float64
-	DebianAllocsPerOp float64
-	IYAAllocsPerOp    float64
-	RHELAllocsPerOp   float64
+	Name   string
+	Metric string
+	// Labels holds the split-key label values (e.g. pkg, goos,
+	// goarch) that this benchmark's analysis group was split on.
+	Labels     map[string]string
+	Values     map[string]Metrics // system name -> metrics
+	BestSystem string
+	// SignificanceTest records which test (if any) was used to
+	// judge every system's speedup against the baseline.
+	SignificanceTest string
+	// PValue holds, for each non-baseline system, the significance
+	// test's p-value against the baseline system.
+	PValue map[string]float64
 }
 
 // TestStyle represents a benchmark test configuration
@@ -41,29 +182,82 @@ type TestStyle struct {
 	Description string
 }
 
-// AnalysisResult holds statistical analysis
+// AnalysisResult holds statistical analysis across every configured system
 type AnalysisResult struct {
-	TestStyle        string
-	TotalBenchmarks  int
-	IYAWins          int
-	DebianWins       int
-	RHELWins         int
-	AvgSpeedupDebian float64
-	AvgSpeedupRHEL   float64
-	MinSpeedup       float64
-	MaxSpeedup       float64
-	MedianSpeedup    float64
+	TestStyle       string
+	TotalBenchmarks int
+	Baseline        string
+	// Wins counts, for every configured system, how many
+	// benchmarks it won outright (lowest ns/op among all systems).
+	// This is the "best of N" projection of PairWins: a system can
+	// lead PairWins against every individual rival yet still lose
+	// Wins to a third system that beats it outright elsewhere.
+	Wins map[string]int
+	// PairWins is the full all-pairs winner matrix: PairWins[a][b]
+	// counts how many benchmarks system a beat system b head-to-head
+	// on ns/op, independent of how any other configured system did.
+	PairWins map[string]map[string]int
+	// AvgSpeedup holds, for every non-baseline system, its average
+	// speedup over the baseline (baseline ns/op / system ns/op)
+	// across benchmarks where it beat the baseline significantly.
+	AvgSpeedup map[string]float64
+	// GeomeanSpeedup holds, for every non-baseline system, the
+	// geometric mean of the same speedups averaged into AvgSpeedup.
+	// Geomean is the standard summary for ratio data: it is
+	// unaffected by which value is chosen as the reciprocal's
+	// baseline and isn't skewed by one outsized speedup the way an
+	// arithmetic mean is.
+	GeomeanSpeedup map[string]float64
+	MinSpeedup     float64
+	MaxSpeedup     float64
+	MedianSpeedup  float64
+	OutlierReports []OutlierReport
+}
+
+// OutlierReport records how many samples were dropped by the IQR
+// outlier rule for a single benchmark, broken down per system and
+// by severity, so a "win" that only holds up because of one skewed
+// run is visible rather than silently averaged away.
+type OutlierReport struct {
+	Name    string
+	Samples map[string]int
+	Mild    map[string]int
+	Severe  map[string]int
 }
 
 func main() {
+	flag.Parse()
+
 	fmt.Println("=== Go Benchmark Analysis Tool ===")
 	fmt.Println()
 
-	// Define benchmark directories
-	dirs := map[string]string{
-		"Debian": "go_benchmark_go_system_debian11",
-		"IYA":    "go_benchmark_go_system_IYA",
-		"RHEL":   "go_benchmark_go_system_rhel",
+	dirs := map[string]string(systems)
+	if len(dirs) == 0 {
+		// Historical default: the three systems this tool originally shipped with.
+		dirs = map[string]string{
+			"Debian": "go_benchmark_go_system_debian11",
+			"IYA":    "go_benchmark_go_system_IYA",
+			"RHEL":   "go_benchmark_go_system_rhel",
+		}
+	}
+
+	systemNames := make([]string, 0, len(dirs))
+	for name := range dirs {
+		systemNames = append(systemNames, name)
+	}
+	sort.Strings(systemNames)
+
+	baselineSystem := *baseline
+	if baselineSystem == "" {
+		if _, ok := dirs["Debian"]; ok {
+			baselineSystem = "Debian"
+		} else {
+			baselineSystem = systemNames[0]
+		}
+	}
+	if _, ok := dirs[baselineSystem]; !ok {
+		fmt.Printf("❌ baseline system %q is not in the configured system set\n", baselineSystem)
+		return
 	}
 
 	// Define test styles
@@ -94,336 +288,744 @@ func main() {
 		},
 	}
 
-	// Analyze each test style
+	keys := splitKeys(*split)
+
+	// Analyze each test style, split into one analysis per distinct
+	// combination of -split label values (e.g. pkg/goos/goarch).
+	// variantOrder records the resulting variant names (style name,
+	// plus a "[key=value, ...]" suffix once a file contains more
+	// than one group) in a stable, deterministic order.
 	allResults := make(map[string]*AnalysisResult)
 	allBenchmarks := make(map[string][]BenchmarkResult)
+	var variantOrder []string
 
 	for _, style := range testStyles {
 		fmt.Printf("📊 Analyzing %s benchmark (%s)...\n", style.Name, style.Duration)
 
-		// Read benchmark data from all three OS directories
-		benchmarks, err := readBenchmarkFiles(dirs, style.Filename)
+		// Read benchmark data from every configured system directory
+		groups, err := readBenchmarkFiles(dirs, style.Filename, keys)
 		if err != nil {
 			fmt.Printf("   ❌ Error reading benchmarks: %v\n\n", err)
 			continue
 		}
 
-		if len(benchmarks) == 0 {
+		if len(groups) == 0 {
 			fmt.Printf("   ⚠️  No benchmarks found\n\n")
 			continue
 		}
 
-		allBenchmarks[style.Name] = benchmarks
-		analysis := analyzeBenchmarks(style.Name, benchmarks)
-		allResults[style.Name] = analysis
+		groupKeys := make([]string, 0, len(groups))
+		for gk := range groups {
+			groupKeys = append(groupKeys, gk)
+		}
+		sort.Strings(groupKeys)
 
-		printAnalysisSummary(analysis)
-		fmt.Println()
+		for _, gk := range groupKeys {
+			benchmarks := groups[gk]
+			variant := style.Name
+			if gk != "" {
+				variant = fmt.Sprintf("%s [%s]", style.Name, gk)
+			}
+
+			allBenchmarks[variant] = benchmarks
+			variantOrder = append(variantOrder, variant)
+			analysis := analyzeBenchmarks(variant, benchmarks, systemNames, baselineSystem)
+			allResults[variant] = analysis
+
+			printAnalysisSummary(analysis, systemNames)
+			fmt.Println()
+		}
 	}
 
 	// Generate comprehensive analysis report
 	if len(allResults) > 0 {
 		fmt.Println("\n=== Generating Comprehensive Analysis Report ===")
-		generateComparisonReport(allResults, allBenchmarks)
-		generateCategoryAnalysis(allBenchmarks)
-		generateWinnerMatrix(allResults)
+		generateComparisonReport(allResults, variantOrder, systemNames)
+		generateCategoryAnalysis(allBenchmarks, systemNames, baselineSystem)
+		generateWinnerMatrix(allResults, systemNames)
 
 		// Export analysis results
-		exportAnalysisCSV(allResults)
-		exportDetailedReport(allBenchmarks, allResults)
+		exportAnalysisCSV(allResults, variantOrder, systemNames, baselineSystem)
+		exportDetailedReport(allBenchmarks, allResults, variantOrder, systemNames, baselineSystem)
+		exportOutlierReportCSV(allResults, variantOrder, systemNames)
 
 		// Export detailed CSV files for each test style
 		fmt.Println("\n📊 Generating detailed CSV files...")
-		exportDetailedCSVFiles(allBenchmarks)
+		exportDetailedCSVFiles(allBenchmarks, variantOrder, systemNames, baselineSystem)
+
+		if *htmlOut != "" {
+			exportHTMLReport(*htmlOut, allBenchmarks, allResults, variantOrder, systemNames, baselineSystem)
+		}
 
 		fmt.Println("\n✅ Analysis complete!")
 		fmt.Println("📁 Generated files:")
 		fmt.Println("   - benchmark_analysis_summary.csv")
 		fmt.Println("   - benchmark_detailed_report.md")
-		fmt.Println("   - go_benchmark_QUICK_comparison.csv")
-		fmt.Println("   - go_benchmark_STANDARD_comparison.csv")
-		fmt.Println("   - go_benchmark_EXTENDED_comparison.csv")
-		fmt.Println("   - go_benchmark_PROFILED_comparison.csv")
+		fmt.Println("   - benchmark_outlier_report.csv")
+		for _, variant := range variantOrder {
+			fmt.Printf("   - %s\n", detailedCSVFilename(variant))
+		}
+		if *htmlOut != "" {
+			fmt.Printf("   - %s\n", *htmlOut)
+		}
 	}
 }
 
-// readCSV reads and parses a CSV benchmark file
-func readCSV(filename string) ([]BenchmarkResult, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+// ParsedSample is one normalized benchmark observation extracted
+// from an input file by a Parser, before per-system/per-group
+// aggregation into a BenchmarkResult. NsOp follows the same "lower
+// is better" convention as Go's ns/op regardless of source format
+// (e.g. a MangoHud parser reports per-frame time, not raw FPS).
+type ParsedSample struct {
+	Name           string
+	Labels         map[string]string
+	NsOp           float64
+	BytesPerOp     float64
+	HasBytesPerOp  bool
+	AllocsPerOp    float64
+	HasAllocsPerOp bool
+}
 
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
-	if err != nil {
-		return nil, err
-	}
+// Parser extracts normalized benchmark samples from one input
+// file's raw bytes. Implementations exist for go test -bench text
+// output, Google Benchmark JSON, and MangoHud CSV frame logs; see
+// detectParser for the format-sniffing that chooses between them.
+type Parser interface {
+	Parse(data []byte) ([]ParsedSample, error)
+}
 
-	var results []BenchmarkResult
+// mangoHudHeader is the fixed system-info column header MangoHud
+// writes as the first line of every CSV log it produces.
+const mangoHudHeader = "os,cpu,gpu,ram,kernel,driver,cpuscheduler"
+
+// detectParser sniffs a file's first line to choose the Parser
+// that understands it: Google Benchmark JSON starts with "{", a
+// MangoHud CSV log's first line is the fixed mangoHudHeader, and
+// everything else is treated as go test -bench text output.
+func detectParser(data []byte) Parser {
+	first := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		first = data[:i]
+	}
+	line := strings.TrimSpace(string(first))
+
+	switch {
+	case strings.HasPrefix(line, "{"):
+		return googleBenchmarkParser{}
+	case line == mangoHudHeader:
+		return mangoHudParser{}
+	default:
+		return goBenchParser{}
+	}
+}
 
-	// Skip header and system info rows
-	for i, record := range records {
-		if i == 0 || len(record) < 5 {
+// goBenchParser parses "go test -bench" text output: optional
+// Go 1.9+ header lines ("pkg: ...", "goos: ...", or any custom
+// "key: value" line) followed by "BenchmarkName-N iterations ns/op
+// [B/op] [allocs/op]" lines. Each header line's labels apply to
+// every benchmark line that follows, until the next header
+// overrides them.
+type goBenchParser struct{}
+
+func (goBenchParser) Parse(data []byte) ([]ParsedSample, error) {
+	var samples []ParsedSample
+	currentLabels := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if !strings.HasPrefix(line, "Benchmark") {
+			if k, v, ok := parseLabelLine(line); ok {
+				currentLabels[k] = v
+			}
 			continue
 		}
 
-		// Skip empty or header rows
-		if record[0] == "" || strings.Contains(record[0], "System Info") ||
-			strings.Contains(record[0], "Test Type") || strings.Contains(record[0], "Summary") {
+		// Parse benchmark lines: BenchmarkName-4  iterations  ns/op  B/op  allocs/op
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
 			continue
 		}
 
-		// Only process ns/op metrics for main analysis
-		if len(record) >= 6 && record[1] == "ns/op" {
-			result := BenchmarkResult{
-				Name:            record[0],
-				Metric:          record[1],
-				Debian:          record[2],
-				IYA:             record[3],
-				RHEL:            record[4],
-				BestPerformance: record[5],
+		sample := ParsedSample{
+			// Extract benchmark name (remove -4 suffix)
+			Name: strings.TrimSuffix(fields[0], "-4"),
+			// Copy currentLabels: it keeps mutating as later header
+			// lines are seen, and every sample must keep the labels
+			// that were in effect when it was parsed.
+			Labels: copyLabels(currentLabels),
+		}
+		if nsOp, err := strconv.ParseFloat(fields[2], 64); err == nil {
+			sample.NsOp = nsOp
+		}
+		if len(fields) >= 5 {
+			if bytesOp, err := strconv.ParseFloat(fields[4], 64); err == nil {
+				sample.BytesPerOp, sample.HasBytesPerOp = bytesOp, true
 			}
-
-			// Parse numeric values
-			result.DebianValue = parseNsOp(record[2])
-			result.IYAValue = parseNsOp(record[3])
-			result.RHELValue = parseNsOp(record[4])
-
-			if result.DebianValue > 0 && result.IYAValue > 0 {
-				results = append(results, result)
+		}
+		if len(fields) >= 7 {
+			if allocsOp, err := strconv.ParseFloat(fields[6], 64); err == nil {
+				sample.AllocsPerOp, sample.HasAllocsPerOp = allocsOp, true
 			}
 		}
+		samples = append(samples, sample)
 	}
 
-	return results, nil
+	return samples, scanner.Err()
 }
 
-// parseNsOp extracts numeric value from ns/op string
-func parseNsOp(s string) float64 {
-	// Remove commas and whitespace
-	s = strings.ReplaceAll(s, ",", "")
-	s = strings.TrimSpace(s)
+// googleBenchmarkParser parses Google Benchmark's --benchmark_format=json
+// output: a top-level "context" object (num_cpus, date, and other
+// machine info) plus a "benchmarks" array of entries with "name",
+// "real_time"/"cpu_time" (in "time_unit"), and "iterations". cpu_time
+// is used as the ns/op-equivalent metric since, like ns/op, it
+// excludes time the benchmark thread spent descheduled. Google
+// Benchmark has no direct equivalent of Go's B/op or allocs/op, so
+// those are left unset.
+type googleBenchmarkParser struct{}
+
+type googleBenchmarkFile struct {
+	Context struct {
+		NumCPUs int    `json:"num_cpus"`
+		Date    string `json:"date"`
+	} `json:"context"`
+	Benchmarks []struct {
+		Name     string  `json:"name"`
+		CPUTime  float64 `json:"cpu_time"`
+		RealTime float64 `json:"real_time"`
+		TimeUnit string  `json:"time_unit"`
+	} `json:"benchmarks"`
+}
 
-	val, err := strconv.ParseFloat(s, 64)
+func (googleBenchmarkParser) Parse(data []byte) ([]ParsedSample, error) {
+	var file googleBenchmarkFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing Google Benchmark JSON: %w", err)
+	}
+
+	labels := make(map[string]string)
+	if file.Context.Date != "" {
+		labels["date"] = file.Context.Date
+	}
+	if file.Context.NumCPUs > 0 {
+		labels["num_cpus"] = strconv.Itoa(file.Context.NumCPUs)
+	}
+
+	samples := make([]ParsedSample, 0, len(file.Benchmarks))
+	for _, b := range file.Benchmarks {
+		samples = append(samples, ParsedSample{
+			Name:   b.Name,
+			Labels: labels,
+			NsOp:   toNanoseconds(b.CPUTime, b.TimeUnit),
+		})
+	}
+	return samples, nil
+}
+
+// toNanoseconds converts a Google Benchmark time value, reported in
+// time_unit ("ns", "us", "ms", or "s"), to nanoseconds.
+func toNanoseconds(value float64, timeUnit string) float64 {
+	switch timeUnit {
+	case "us":
+		return value * 1e3
+	case "ms":
+		return value * 1e6
+	case "s":
+		return value * 1e9
+	default: // "ns", or unrecognized
+		return value
+	}
+}
+
+// mangoHudParser parses a MangoHud CSV frame log: a system-info
+// header/value row pair (mangoHudHeader, then the actual os/cpu/gpu/
+// etc. values), followed by a frame-column header and one row per
+// captured frame. The per-frame "fps" column is aggregated into
+// mean/p1/p99 FPS, reported as three synthetic benchmarks ("FPS
+// mean", "FPS p1", "FPS p99") whose ns/op-equivalent value is the
+// corresponding frame time (1e9/fps), preserving the "lower is
+// better" convention the rest of the tool assumes.
+type mangoHudParser struct{}
+
+func (mangoHudParser) Parse(data []byte) ([]ParsedSample, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
 	if err != nil {
-		return 0
+		return nil, fmt.Errorf("parsing MangoHud CSV: %w", err)
 	}
-	return val
+	if len(records) < 4 {
+		return nil, fmt.Errorf("MangoHud CSV has no frame data")
+	}
+
+	sysHeader, sysValues, frameHeader, frameRows := records[0], records[1], records[2], records[3:]
+	labels := make(map[string]string, len(sysHeader))
+	for i, key := range sysHeader {
+		if i < len(sysValues) {
+			labels[key] = sysValues[i]
+		}
+	}
+
+	fpsCol := -1
+	for i, col := range frameHeader {
+		if col == "fps" {
+			fpsCol = i
+			break
+		}
+	}
+	if fpsCol == -1 {
+		return nil, fmt.Errorf("MangoHud CSV frame header has no fps column")
+	}
+
+	var fps []float64
+	for _, row := range frameRows {
+		if fpsCol >= len(row) {
+			continue
+		}
+		if v, err := strconv.ParseFloat(row[fpsCol], 64); err == nil && v > 0 {
+			fps = append(fps, v)
+		}
+	}
+	if len(fps) == 0 {
+		return nil, fmt.Errorf("MangoHud CSV has no valid fps samples")
+	}
+
+	sorted := make([]float64, len(fps))
+	copy(sorted, fps)
+	sort.Float64s(sorted)
+
+	return []ParsedSample{
+		{Name: "FPS mean", Labels: labels, NsOp: average(frameTimesNs(fps))},
+		{Name: "FPS p1", Labels: labels, NsOp: 1e9 / percentileInterp(sorted, 0.01)},
+		{Name: "FPS p99", Labels: labels, NsOp: 1e9 / percentileInterp(sorted, 0.99)},
+	}, nil
 }
 
-// readBenchmarkFiles reads benchmark data from result directories
-func readBenchmarkFiles(dirs map[string]string, filename string) ([]BenchmarkResult, error) {
-	type BenchmarkMetrics struct {
+// frameTimesNs converts a series of per-frame FPS values to
+// per-frame times in nanoseconds.
+func frameTimesNs(fps []float64) []float64 {
+	times := make([]float64, len(fps))
+	for i, v := range fps {
+		times[i] = 1e9 / v
+	}
+	return times
+}
+
+// readBenchmarkFiles reads benchmark data from the configured
+// system directories for an arbitrary number of systems, auto-
+// detecting each file's format (see detectParser) and splitting
+// the result into one []BenchmarkResult per distinct combination of
+// keys' label values (see the -split flag). The map key is the
+// group's canonical groupKeyFor string; files with no recognized
+// header labels all fall into the single "" group, matching the
+// tool's historical unsplit behavior.
+func readBenchmarkFiles(dirs map[string]string, filename string, keys []string) (map[string][]BenchmarkResult, error) {
+	type rawMetrics struct {
 		nsOp     float64
 		bytesOp  float64
 		allocsOp float64
+		// samples accumulates every ns/op value seen for this
+		// benchmark, one per iteration line, so test styles that
+		// run multiple times (e.g. Extended) preserve the full
+		// distribution instead of only the last run.
+		samples []float64
 	}
-	benchmarkData := make(map[string]map[string]*BenchmarkMetrics) // benchmark -> OS -> metrics
+	type benchKey struct {
+		name     string
+		groupKey string
+	}
+	benchmarkData := make(map[benchKey]map[string]*rawMetrics) // (benchmark, group) -> system -> metrics
+	groupLabels := make(map[string]map[string]string)          // groupKey -> split-key label values
 
-	for osName, dir := range dirs {
+	for systemName, dir := range dirs {
 		filePath := filepath.Join(dir, filename)
 
-		file, err := os.Open(filePath)
+		data, err := os.ReadFile(filePath)
 		if err != nil {
 			fmt.Printf("   ⚠️  Cannot read %s: %v\n", filePath, err)
 			continue
 		}
-		defer file.Close()
-
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			line := scanner.Text()
-
-			// Parse benchmark lines: BenchmarkName-4  iterations  ns/op  B/op  allocs/op
-			if strings.HasPrefix(line, "Benchmark") {
-				fields := strings.Fields(line)
-				if len(fields) < 3 {
-					continue
-				}
 
-				// Extract benchmark name (remove -4 suffix)
-				name := strings.TrimSuffix(fields[0], "-4")
-
-				metrics := &BenchmarkMetrics{}
-
-				// Parse ns/op value (3rd field)
-				if nsOp, err := strconv.ParseFloat(fields[2], 64); err == nil {
-					metrics.nsOp = nsOp
-				}
-
-				// Parse B/op value (5th field if exists)
-				if len(fields) >= 5 {
-					if bytesOp, err := strconv.ParseFloat(fields[4], 64); err == nil {
-						metrics.bytesOp = bytesOp
-					}
-				}
+		samples, err := detectParser(data).Parse(data)
+		if err != nil {
+			return nil, err
+		}
 
-				// Parse allocs/op value (7th field if exists)
-				if len(fields) >= 7 {
-					if allocsOp, err := strconv.ParseFloat(fields[6], 64); err == nil {
-						metrics.allocsOp = allocsOp
+		for _, s := range samples {
+			groupKey := groupKeyFor(s.Labels, keys)
+			if _, ok := groupLabels[groupKey]; !ok {
+				var labels map[string]string
+				for _, k := range keys {
+					if v, ok := s.Labels[k]; ok {
+						if labels == nil {
+							labels = make(map[string]string, len(keys))
+						}
+						labels[k] = v
 					}
 				}
+				groupLabels[groupKey] = labels
+			}
 
-				if benchmarkData[name] == nil {
-					benchmarkData[name] = make(map[string]*BenchmarkMetrics)
-				}
-				benchmarkData[name][osName] = metrics
+			bk := benchKey{s.Name, groupKey}
+			if benchmarkData[bk] == nil {
+				benchmarkData[bk] = make(map[string]*rawMetrics)
+			}
+			metrics := benchmarkData[bk][systemName]
+			if metrics == nil {
+				metrics = &rawMetrics{}
+				benchmarkData[bk][systemName] = metrics
 			}
-		}
 
-		if err := scanner.Err(); err != nil {
-			return nil, err
+			// Every sample updates the "current" value and is also
+			// appended to samples so repeated runs of the same
+			// benchmark preserve the full distribution.
+			metrics.nsOp = s.NsOp
+			metrics.samples = append(metrics.samples, s.NsOp)
+			if s.HasBytesPerOp {
+				metrics.bytesOp = s.BytesPerOp
+			}
+			if s.HasAllocsPerOp {
+				metrics.allocsOp = s.AllocsPerOp
+			}
 		}
 	}
 
-	// Convert to BenchmarkResult slice
-	var results []BenchmarkResult
-	for name, osData := range benchmarkData {
-		// Only include benchmarks that have data from all three OS
-		if len(osData) < 3 {
+	// Convert to BenchmarkResult slices, grouped by groupKey.
+	grouped := make(map[string][]BenchmarkResult)
+	for bk, systemData := range benchmarkData {
+		// Only include benchmarks that have data from every configured system
+		if len(systemData) < len(dirs) {
 			continue
 		}
 
-		result := BenchmarkResult{
-			Name:        name,
-			Metric:      "ns/op",
-			DebianValue: osData["Debian"].nsOp,
-			IYAValue:    osData["IYA"].nsOp,
-			RHELValue:   osData["RHEL"].nsOp,
-			// Additional metrics
-			DebianBytesPerOp:  osData["Debian"].bytesOp,
-			IYABytesPerOp:     osData["IYA"].bytesOp,
-			RHELBytesPerOp:    osData["RHEL"].bytesOp,
-			DebianAllocsPerOp: osData["Debian"].allocsOp,
-			IYAAllocsPerOp:    osData["IYA"].allocsOp,
-			RHELAllocsPerOp:   osData["RHEL"].allocsOp,
+		values := make(map[string]Metrics, len(systemData))
+		for systemName, m := range systemData {
+			values[systemName] = Metrics{
+				NsOp:        m.nsOp,
+				BytesPerOp:  m.bytesOp,
+				AllocsPerOp: m.allocsOp,
+				Samples:     m.samples,
+			}
 		}
 
-		// Format string values
-		result.Debian = fmt.Sprintf("%.2f", result.DebianValue)
-		result.IYA = fmt.Sprintf("%.2f", result.IYAValue)
-		result.RHEL = fmt.Sprintf("%.2f", result.RHELValue)
+		grouped[bk.groupKey] = append(grouped[bk.groupKey], BenchmarkResult{
+			Name:   bk.name,
+			Metric: "ns/op",
+			Labels: groupLabels[bk.groupKey],
+			Values: values,
+			PValue: make(map[string]float64),
+		})
+	}
 
-		// Determine best performance (lowest ns/op is best)
-		minValue := result.DebianValue
-		result.BestPerformance = "Debian"
+	// Sort each group's benchmarks by name.
+	for groupKey := range grouped {
+		results := grouped[groupKey]
+		sort.Slice(results, func(i, j int) bool {
+			return results[i].Name < results[j].Name
+		})
+		grouped[groupKey] = results
+	}
 
-		if result.IYAValue < minValue {
-			minValue = result.IYAValue
-			result.BestPerformance = "IYA"
-		}
+	return grouped, nil
+}
+
+// percentileInterp returns the p-th percentile (0 <= p <= 1) of an
+// already-sorted slice using linear interpolation between closest
+// ranks, matching the convention used for Q1/Q3 below.
+func percentileInterp(sorted []float64, p float64) float64 {
+	n := len(sorted)
+	if n == 0 {
+		return 0
+	}
+	if n == 1 {
+		return sorted[0]
+	}
+	rank := p * float64(n-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo])
+}
 
-		if result.RHELValue < minValue {
-			result.BestPerformance = "RHEL"
+// bootstrapCI computes a 95% confidence interval on the mean of
+// samples via the percentile bootstrap: it draws resamples
+// resamples-with-replacement of the same size as samples, computes
+// each resample's mean, and returns the 2.5th/97.5th percentiles of
+// that distribution. Returns (0, 0) when resamples <= 0 (disabled
+// via -bootstrap) or there are too few samples to resample
+// meaningfully.
+func bootstrapCI(samples []float64, resamples int) (low, high float64) {
+	if resamples <= 0 || len(samples) < 2 {
+		return 0, 0
+	}
+
+	means := make([]float64, resamples)
+	resample := make([]float64, len(samples))
+	for i := range means {
+		for j := range resample {
+			resample[j] = samples[rand.Intn(len(samples))]
 		}
+		means[i] = average(resample)
+	}
+	sort.Float64s(means)
 
-		results = append(results, result)
+	return percentileInterp(means, 0.025), percentileInterp(means, 0.975)
+}
+
+// iqrOutliers applies the standard interquartile-range rule to
+// samples, returning the values that fall within [Q1-1.5*IQR,
+// Q3+1.5*IQR] plus counts of "mild" (beyond 1.5*IQR) and "severe"
+// (beyond 3*IQR) outliers. Samples too small to form a reliable
+// quartile estimate (fewer than 4) are returned untouched.
+func iqrOutliers(samples []float64) (kept []float64, mild, severe int) {
+	if len(samples) < 4 {
+		return samples, 0, 0
 	}
 
-	// Sort by benchmark name
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Name < results[j].Name
-	})
+	sorted := make([]float64, len(samples))
+	copy(sorted, samples)
+	sort.Float64s(sorted)
 
-	return results, nil
+	q1 := percentileInterp(sorted, 0.25)
+	q3 := percentileInterp(sorted, 0.75)
+	iqr := q3 - q1
+
+	mildLow, mildHigh := q1-1.5*iqr, q3+1.5*iqr
+	severeLow, severeHigh := q1-3*iqr, q3+3*iqr
+
+	for _, v := range samples {
+		switch {
+		case v < severeLow || v > severeHigh:
+			severe++
+		case v < mildLow || v > mildHigh:
+			mild++
+		default:
+			kept = append(kept, v)
+		}
+	}
+	return kept, mild, severe
 }
 
-// analyzeBenchmarks performs statistical analysis on benchmark results
-func analyzeBenchmarks(styleName string, benchmarks []BenchmarkResult) *AnalysisResult {
+// significant reports whether the configured -delta-test finds a
+// statistically significant difference between two per-system
+// sample sets. With "none" (the default) every comparison is
+// treated as significant, preserving the historical mean-only
+// behavior.
+func significant(x, y []float64) (pValue float64, isSignificant bool) {
+	switch *deltaTest {
+	case "utest":
+		p := mannWhitneyPValue(x, y)
+		return p, p <= significanceThreshold
+	case "ttest":
+		p := welchTTestPValue(x, y)
+		return p, p <= significanceThreshold
+	default:
+		return 0, true
+	}
+}
+
+// analyzeBenchmarks performs statistical analysis on benchmark
+// results across every configured system, relative to baseline.
+func analyzeBenchmarks(styleName string, benchmarks []BenchmarkResult, systemNames []string, baseline string) *AnalysisResult {
 	analysis := &AnalysisResult{
 		TestStyle:       styleName,
 		TotalBenchmarks: len(benchmarks),
+		Baseline:        baseline,
+		Wins:            make(map[string]int),
+		PairWins:        make(map[string]map[string]int, len(systemNames)),
+		AvgSpeedup:      make(map[string]float64),
+		GeomeanSpeedup:  make(map[string]float64),
+	}
+	for _, sys := range systemNames {
+		analysis.PairWins[sys] = make(map[string]int, len(systemNames)-1)
 	}
 
-	var speedupsDebian []float64
-	var speedupsRHEL []float64
+	speedupsBySystem := make(map[string][]float64)
+	var allSpeedups []float64
 
-	for _, b := range benchmarks {
-		// Determine winner
-		if b.IYAValue > 0 && b.DebianValue > 0 {
-			if b.IYAValue < b.DebianValue {
-				analysis.IYAWins++
-				speedup := b.DebianValue / b.IYAValue
-				speedupsDebian = append(speedupsDebian, speedup)
-			} else {
-				analysis.DebianWins++
+	for i := range benchmarks {
+		b := &benchmarks[i]
+		b.SignificanceTest = *deltaTest
+		if b.PValue == nil {
+			b.PValue = make(map[string]float64)
+		}
+
+		// Trim IQR outliers per system before computing means,
+		// win/speedup decisions, or significance.
+		kept := make(map[string][]float64, len(systemNames))
+		mild := make(map[string]int, len(systemNames))
+		severe := make(map[string]int, len(systemNames))
+		anyOutliers := false
+
+		for _, sys := range systemNames {
+			k, mi, se := iqrOutliers(b.Values[sys].Samples)
+			kept[sys] = k
+			mild[sys] = mi
+			severe[sys] = se
+			if mi+se > 0 {
+				anyOutliers = true
+			}
+			if len(k) > 0 {
+				m := b.Values[sys]
+				m.NsOp = average(k)
+				m.CILow, m.CIHigh = bootstrapCI(k, *bootstrapResamples)
+				b.Values[sys] = m
+			}
+		}
+
+		if anyOutliers {
+			samples := make(map[string]int, len(systemNames))
+			for _, sys := range systemNames {
+				samples[sys] = len(b.Values[sys].Samples)
+			}
+			analysis.OutlierReports = append(analysis.OutlierReports, OutlierReport{
+				Name:    b.Name,
+				Samples: samples,
+				Mild:    mild,
+				Severe:  severe,
+			})
+		}
+
+		// Head-to-head: every ordered pair of systems where one beat
+		// the other on ns/op, independent of how any other system did.
+		for _, a := range systemNames {
+			for _, c := range systemNames {
+				if a == c {
+					continue
+				}
+				if b.Values[a].NsOp < b.Values[c].NsOp {
+					analysis.PairWins[a][c]++
+				}
 			}
 		}
 
-		if b.IYAValue > 0 && b.RHELValue > 0 {
-			if b.IYAValue < b.RHELValue {
-				speedup := b.RHELValue / b.IYAValue
-				speedupsRHEL = append(speedupsRHEL, speedup)
+		// Best system: lowest ns/op among all configured systems,
+		// i.e. the all-pairs winner matrix collapsed to "best of N".
+		b.BestSystem = systemNames[0]
+		bestValue := b.Values[systemNames[0]].NsOp
+		for _, sys := range systemNames[1:] {
+			if v := b.Values[sys].NsOp; v < bestValue {
+				bestValue = v
+				b.BestSystem = sys
+			}
+		}
+		analysis.Wins[b.BestSystem]++
+
+		// Speedup of every non-baseline system relative to baseline.
+		baseValue := b.Values[baseline].NsOp
+		baseKept := kept[baseline]
+		for _, sys := range systemNames {
+			if sys == baseline {
+				continue
+			}
+			sysValue := b.Values[sys].NsOp
+			if sysValue <= 0 || baseValue <= 0 {
+				continue
+			}
+
+			p, isSignificant := significant(kept[sys], baseKept)
+			b.PValue[sys] = p
+			if !isSignificant {
+				continue
+			}
+
+			if sysValue < baseValue {
+				speedup := baseValue / sysValue
+				speedupsBySystem[sys] = append(speedupsBySystem[sys], speedup)
+				allSpeedups = append(allSpeedups, speedup)
 			}
 		}
 	}
 
-	// Calculate statistics
-	if len(speedupsDebian) > 0 {
-		analysis.AvgSpeedupDebian = average(speedupsDebian)
-		analysis.MinSpeedup = min(speedupsDebian)
-		analysis.MaxSpeedup = max(speedupsDebian)
-		analysis.MedianSpeedup = median(speedupsDebian)
+	for _, sys := range systemNames {
+		if sys == baseline {
+			continue
+		}
+		if s := speedupsBySystem[sys]; len(s) > 0 {
+			analysis.AvgSpeedup[sys] = average(s)
+			analysis.GeomeanSpeedup[sys] = geomean(s)
+		}
 	}
 
-	if len(speedupsRHEL) > 0 {
-		analysis.AvgSpeedupRHEL = average(speedupsRHEL)
+	if len(allSpeedups) > 0 {
+		analysis.MinSpeedup = min(allSpeedups)
+		analysis.MaxSpeedup = max(allSpeedups)
+		analysis.MedianSpeedup = median(allSpeedups)
 	}
 
 	return analysis
 }
 
 // printAnalysisSummary prints analysis results to console
-func printAnalysisSummary(a *AnalysisResult) {
+func printAnalysisSummary(a *AnalysisResult, systemNames []string) {
 	fmt.Printf("   Total Benchmarks: %d\n", a.TotalBenchmarks)
-	fmt.Printf("   IYA Linux Wins: %d (%.1f%%)\n", a.IYAWins, float64(a.IYAWins)/float64(a.TotalBenchmarks)*100)
-	if a.DebianWins > 0 {
-		fmt.Printf("   Debian Wins: %d\n", a.DebianWins)
+	for _, sys := range systemNames {
+		label := sys
+		if sys == a.Baseline {
+			label += " (baseline)"
+		}
+		wins := a.Wins[sys]
+		fmt.Printf("   %s Wins: %d (%.1f%%)\n", label, wins, float64(wins)/float64(a.TotalBenchmarks)*100)
 	}
-	if a.RHELWins > 0 {
-		fmt.Printf("   RHEL Wins: %d\n", a.RHELWins)
+	if len(systemNames) > 2 {
+		fmt.Println("   Head-to-head:")
+		for _, sysA := range systemNames {
+			for _, sysB := range systemNames {
+				if sysA == sysB {
+					continue
+				}
+				fmt.Printf("     %s beat %s: %d/%d\n", sysA, sysB, a.PairWins[sysA][sysB], a.TotalBenchmarks)
+			}
+		}
+	}
+	for _, sys := range systemNames {
+		if sys == a.Baseline {
+			continue
+		}
+		fmt.Printf("   Avg Speedup of %s vs %s: %.2fx (geomean %.2fx)\n", sys, a.Baseline, a.AvgSpeedup[sys], a.GeomeanSpeedup[sys])
 	}
-	fmt.Printf("   Avg Speedup vs Debian: %.2fx\n", a.AvgSpeedupDebian)
-	fmt.Printf("   Avg Speedup vs RHEL: %.2fx\n", a.AvgSpeedupRHEL)
 	fmt.Printf("   Min/Max Speedup: %.2fx / %.2fx\n", a.MinSpeedup, a.MaxSpeedup)
 	fmt.Printf("   Median Speedup: %.2fx\n", a.MedianSpeedup)
 }
 
 // generateComparisonReport creates a comparison across all test styles
-func generateComparisonReport(results map[string]*AnalysisResult, benchmarks map[string][]BenchmarkResult) {
+func generateComparisonReport(results map[string]*AnalysisResult, variantOrder []string, systemNames []string) {
 	fmt.Println("\n📈 Cross-Style Comparison:")
-	fmt.Println("┌──────────────┬─────────┬──────────┬─────────────┬─────────────┐")
-	fmt.Println("│ Test Style   │ Total   │ IYA Wins │ Avg vs Deb  │ Avg vs RHEL │")
-	fmt.Println("├──────────────┼─────────┼──────────┼─────────────┼─────────────┤")
 
-	styles := []string{"Quick", "Standard", "Extended", "Profiled"}
-	for _, style := range styles {
-		if r, ok := results[style]; ok {
-			winRate := float64(r.IYAWins) / float64(r.TotalBenchmarks) * 100
-			fmt.Printf("│ %-12s │ %7d │ %3d (%4.1f%%) │ %9.2fx │ %9.2fx │\n",
-				style, r.TotalBenchmarks, r.IYAWins, winRate, r.AvgSpeedupDebian, r.AvgSpeedupRHEL)
+	for _, style := range variantOrder {
+		r, ok := results[style]
+		if !ok {
+			continue
+		}
+		fmt.Printf("   %-10s total=%d", style, r.TotalBenchmarks)
+		for _, sys := range systemNames {
+			fmt.Printf("  %s-wins=%d", sys, r.Wins[sys])
 		}
+		fmt.Println()
 	}
-	fmt.Println("└──────────────┴─────────┴──────────┴─────────────┴─────────────┘")
+}
+
+// benchmarkCategories maps each reporting category to the benchmark
+// name substrings that belong to it, shared by generateCategoryAnalysis
+// and exportHTMLReport so the two stay in sync.
+var benchmarkCategories = map[string][]string{
+	"CPU-Intensive": {"Fibonacci", "Prime", "Matrix"},
+	"Memory":        {"Sorting", "MemoryAllocation", "Map", "Alloc", "Pool", "GCPressure"},
+	"String":        {"String", "StringBuilder"},
+	"JSON":          {"JSON", "Code"},
+	"Crypto":        {"SHA256", "ECDSA"},
+	"Concurrency":   {"Goroutines", "Channel", "Mutex", "FalseSharing"},
 }
 
 // generateCategoryAnalysis analyzes performance by benchmark category
-func generateCategoryAnalysis(benchmarks map[string][]BenchmarkResult) {
+func generateCategoryAnalysis(benchmarks map[string][]BenchmarkResult, systemNames []string, baseline string) {
 	fmt.Println("\n📊 Category Analysis:")
 
-	categories := map[string][]string{
-		"CPU-Intensive": {"Fibonacci", "Prime", "Matrix"},
-		"Memory":        {"Sorting", "MemoryAllocation", "Map"},
-		"String":        {"String", "StringBuilder"},
-		"JSON":          {"JSON"},
-		"Crypto":        {"SHA256"},
-		"Concurrency":   {"Goroutines", "Channel", "Mutex"},
-	}
-
-	for catName, keywords := range categories {
+	for catName, keywords := range benchmarkCategories {
 		fmt.Printf("\n%s:\n", catName)
 
 		for styleName, results := range benchmarks {
@@ -432,30 +1034,41 @@ func generateCategoryAnalysis(benchmarks map[string][]BenchmarkResult) {
 				continue
 			}
 
-			avgSpeedup := calculateAvgSpeedup(catResults)
-			fmt.Printf("  %-12s: %.2fx faster (IYA Linux)\n", styleName, avgSpeedup)
+			for _, sys := range systemNames {
+				if sys == baseline {
+					continue
+				}
+				avgSpeedup := calculateAvgSpeedup(catResults, baseline, sys)
+				if avgSpeedup > 0 {
+					fmt.Printf("  %-12s: %s %.2fx faster than %s\n", styleName, sys, avgSpeedup, baseline)
+				}
+			}
 		}
 	}
 }
 
 // generateWinnerMatrix shows consistency across test styles
-func generateWinnerMatrix(results map[string]*AnalysisResult) {
+func generateWinnerMatrix(results map[string]*AnalysisResult, systemNames []string) {
 	fmt.Println("\n🏆 Winner Consistency Matrix:")
 
-	totalWins := 0
+	totalWins := make(map[string]int)
 	totalTests := 0
 
 	for _, r := range results {
-		totalWins += r.IYAWins
 		totalTests += r.TotalBenchmarks
+		for _, sys := range systemNames {
+			totalWins[sys] += r.Wins[sys]
+		}
 	}
 
-	overallWinRate := float64(totalWins) / float64(totalTests) * 100
-	fmt.Printf("   Overall: IYA Linux wins %d out of %d (%.1f%%)\n", totalWins, totalTests, overallWinRate)
+	for _, sys := range systemNames {
+		winRate := float64(totalWins[sys]) / float64(totalTests) * 100
+		fmt.Printf("   %s: %d/%d (%.1f%%)\n", sys, totalWins[sys], totalTests, winRate)
+	}
 }
 
 // exportAnalysisCSV exports analysis summary to CSV
-func exportAnalysisCSV(results map[string]*AnalysisResult) {
+func exportAnalysisCSV(results map[string]*AnalysisResult, variantOrder []string, systemNames []string, baseline string) {
 	filename := "benchmark_analysis_summary.csv"
 	file, err := os.Create(filename)
 	if err != nil {
@@ -467,32 +1080,98 @@ func exportAnalysisCSV(results map[string]*AnalysisResult) {
 	writer := csv.NewWriter(file)
 	defer writer.Flush()
 
-	// Write header
-	writer.Write([]string{"Test Style", "Total Benchmarks", "IYA Wins", "Win Rate %",
-		"Avg Speedup vs Debian", "Avg Speedup vs RHEL", "Min Speedup", "Max Speedup", "Median Speedup"})
-
-	// Write data
-	styles := []string{"Quick", "Standard", "Extended", "Profiled"}
-	for _, style := range styles {
-		if r, ok := results[style]; ok {
-			winRate := float64(r.IYAWins) / float64(r.TotalBenchmarks) * 100
-			writer.Write([]string{
-				style,
-				fmt.Sprintf("%d", r.TotalBenchmarks),
-				fmt.Sprintf("%d", r.IYAWins),
-				fmt.Sprintf("%.2f", winRate),
-				fmt.Sprintf("%.2f", r.AvgSpeedupDebian),
-				fmt.Sprintf("%.2f", r.AvgSpeedupRHEL),
-				fmt.Sprintf("%.2f", r.MinSpeedup),
-				fmt.Sprintf("%.2f", r.MaxSpeedup),
-				fmt.Sprintf("%.2f", r.MedianSpeedup),
-			})
+	header := []string{"Test Style", "Total Benchmarks"}
+	for _, sys := range systemNames {
+		header = append(header, sys+" Wins")
+	}
+	for _, sys := range systemNames {
+		if sys == baseline {
+			continue
+		}
+		header = append(header, fmt.Sprintf("Avg Speedup (%s vs %s)", sys, baseline))
+	}
+	for _, sys := range systemNames {
+		if sys == baseline {
+			continue
+		}
+		header = append(header, fmt.Sprintf("Geomean Speedup (%s vs %s)", sys, baseline))
+	}
+	header = append(header, "Min Speedup", "Max Speedup", "Median Speedup")
+	writer.Write(header)
+
+	for _, style := range variantOrder {
+		r, ok := results[style]
+		if !ok {
+			continue
+		}
+
+		row := []string{style, fmt.Sprintf("%d", r.TotalBenchmarks)}
+		for _, sys := range systemNames {
+			row = append(row, fmt.Sprintf("%d", r.Wins[sys]))
+		}
+		for _, sys := range systemNames {
+			if sys == baseline {
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.2f", r.AvgSpeedup[sys]))
+		}
+		for _, sys := range systemNames {
+			if sys == baseline {
+				continue
+			}
+			row = append(row, fmt.Sprintf("%.2f", r.GeomeanSpeedup[sys]))
+		}
+		row = append(row,
+			fmt.Sprintf("%.2f", r.MinSpeedup),
+			fmt.Sprintf("%.2f", r.MaxSpeedup),
+			fmt.Sprintf("%.2f", r.MedianSpeedup),
+		)
+		writer.Write(row)
+	}
+}
+
+// exportOutlierReportCSV writes per-benchmark IQR outlier counts
+// across every test style, so a "win" caused by a single skewed
+// run is visible alongside the speedup numbers.
+func exportOutlierReportCSV(results map[string]*AnalysisResult, variantOrder []string, systemNames []string) {
+	filename := "benchmark_outlier_report.csv"
+	file, err := os.Create(filename)
+	if err != nil {
+		fmt.Printf("Error creating %s: %v\n", filename, err)
+		return
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	header := []string{"Test Style", "Benchmark"}
+	for _, sys := range systemNames {
+		header = append(header, sys+" Samples", "Mild "+sys, "Severe "+sys)
+	}
+	writer.Write(header)
+
+	for _, style := range variantOrder {
+		r, ok := results[style]
+		if !ok {
+			continue
+		}
+		for _, o := range r.OutlierReports {
+			row := []string{style, o.Name}
+			for _, sys := range systemNames {
+				row = append(row,
+					fmt.Sprintf("%d", o.Samples[sys]),
+					fmt.Sprintf("%d", o.Mild[sys]),
+					fmt.Sprintf("%d", o.Severe[sys]),
+				)
+			}
+			writer.Write(row)
 		}
 	}
 }
 
 // exportDetailedReport generates a detailed markdown report
-func exportDetailedReport(benchmarks map[string][]BenchmarkResult, results map[string]*AnalysisResult) {
+func exportDetailedReport(benchmarks map[string][]BenchmarkResult, results map[string]*AnalysisResult, variantOrder []string, systemNames []string, baseline string) {
 	filename := "benchmark_detailed_report.md"
 	file, err := os.Create(filename)
 	if err != nil {
@@ -503,49 +1182,65 @@ func exportDetailedReport(benchmarks map[string][]BenchmarkResult, results map[s
 
 	fmt.Fprintf(file, "# Comprehensive Benchmark Analysis Report\n\n")
 	fmt.Fprintf(file, "Generated: %s\n\n", filepath.Base(os.Args[0]))
+	fmt.Fprintf(file, "Systems compared: %s (baseline: %s)\n\n", strings.Join(systemNames, ", "), baseline)
 
 	fmt.Fprintf(file, "## Executive Summary\n\n")
 
-	totalWins := 0
+	totalWins := make(map[string]int)
 	totalTests := 0
 	for _, r := range results {
-		totalWins += r.IYAWins
 		totalTests += r.TotalBenchmarks
+		for _, sys := range systemNames {
+			totalWins[sys] += r.Wins[sys]
+		}
 	}
 
-	fmt.Fprintf(file, "- **Total Benchmarks Analyzed**: %d across 4 test styles\n", totalTests)
-	fmt.Fprintf(file, "- **IYA Linux Overall Win Rate**: %d/%d (%.1f%%)\n", totalWins, totalTests,
-		float64(totalWins)/float64(totalTests)*100)
-	fmt.Fprintf(file, "- **Performance Advantage**: 5-8x faster on average\n\n")
+	fmt.Fprintf(file, "- **Total Benchmarks Analyzed**: %d across %d test style/group variants\n", totalTests, len(variantOrder))
+	for _, sys := range systemNames {
+		fmt.Fprintf(file, "- **%s Overall Win Rate**: %d/%d (%.1f%%)\n", sys, totalWins[sys], totalTests,
+			float64(totalWins[sys])/float64(totalTests)*100)
+	}
+	fmt.Fprintf(file, "\n")
 
 	fmt.Fprintf(file, "## Results by Test Style\n\n")
 
-	styles := []string{"Quick", "Standard", "Extended", "Profiled"}
-	for _, style := range styles {
-		if r, ok := results[style]; ok {
-			fmt.Fprintf(file, "### %s Benchmark\n\n", style)
-			fmt.Fprintf(file, "- Total Tests: %d\n", r.TotalBenchmarks)
-			fmt.Fprintf(file, "- IYA Linux Wins: %d (%.1f%%)\n", r.IYAWins,
-				float64(r.IYAWins)/float64(r.TotalBenchmarks)*100)
-			fmt.Fprintf(file, "- Average Speedup vs Debian: %.2fx\n", r.AvgSpeedupDebian)
-			fmt.Fprintf(file, "- Average Speedup vs RHEL: %.2fx\n", r.AvgSpeedupRHEL)
-			fmt.Fprintf(file, "- Speedup Range: %.2fx - %.2fx\n\n", r.MinSpeedup, r.MaxSpeedup)
+	for _, style := range variantOrder {
+		r, ok := results[style]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(file, "### %s Benchmark\n\n", style)
+		fmt.Fprintf(file, "- Total Tests: %d\n", r.TotalBenchmarks)
+		for _, sys := range systemNames {
+			fmt.Fprintf(file, "- %s Wins: %d (%.1f%%)\n", sys, r.Wins[sys],
+				float64(r.Wins[sys])/float64(r.TotalBenchmarks)*100)
+		}
+		for _, sys := range systemNames {
+			if sys == baseline {
+				continue
+			}
+			fmt.Fprintf(file, "- Average Speedup of %s vs %s: %.2fx\n", sys, baseline, r.AvgSpeedup[sys])
 		}
+		fmt.Fprintf(file, "- Speedup Range: %.2fx - %.2fx\n\n", r.MinSpeedup, r.MaxSpeedup)
 	}
 
 	fmt.Fprintf(file, "## Top 10 Performance Gains\n\n")
-	writeTopPerformers(file, benchmarks)
+	writeTopPerformers(file, benchmarks, systemNames, baseline)
+
+	fmt.Fprintf(file, "\n## Outlier Report\n\n")
+	writeOutlierReport(file, results, variantOrder, systemNames)
 
 	fmt.Fprintf(file, "\n## Conclusion\n\n")
-	fmt.Fprintf(file, "IYA Linux with kernel 6.16.5 demonstrates consistent and significant ")
-	fmt.Fprintf(file, "performance advantages across all benchmark styles and categories.\n")
+	fmt.Fprintf(file, "See the per-style results above for how each configured system compares against %s.\n", baseline)
 }
 
 // writeTopPerformers writes the top 10 benchmarks with highest speedup
-func writeTopPerformers(file *os.File, benchmarks map[string][]BenchmarkResult) {
+func writeTopPerformers(file *os.File, benchmarks map[string][]BenchmarkResult, systemNames []string, baseline string) {
 	type SpeedupResult struct {
 		Name    string
 		Style   string
+		Labels  string
+		System  string
 		Speedup float64
 	}
 
@@ -553,13 +1248,24 @@ func writeTopPerformers(file *os.File, benchmarks map[string][]BenchmarkResult)
 
 	for style, results := range benchmarks {
 		for _, r := range results {
-			if r.DebianValue > 0 && r.IYAValue > 0 && r.IYAValue < r.DebianValue {
-				speedup := r.DebianValue / r.IYAValue
-				allSpeedups = append(allSpeedups, SpeedupResult{
-					Name:    r.Name,
-					Style:   style,
-					Speedup: speedup,
-				})
+			baseValue := r.Values[baseline].NsOp
+			if baseValue <= 0 {
+				continue
+			}
+			for _, sys := range systemNames {
+				if sys == baseline {
+					continue
+				}
+				sysValue := r.Values[sys].NsOp
+				if sysValue > 0 && sysValue < baseValue {
+					allSpeedups = append(allSpeedups, SpeedupResult{
+						Name:    r.Name,
+						Style:   style,
+						Labels:  labelString(r.Labels),
+						System:  sys,
+						Speedup: baseValue / sysValue,
+					})
+				}
 			}
 		}
 	}
@@ -568,12 +1274,50 @@ func writeTopPerformers(file *os.File, benchmarks map[string][]BenchmarkResult)
 		return allSpeedups[i].Speedup > allSpeedups[j].Speedup
 	})
 
-	fmt.Fprintf(file, "| Rank | Benchmark | Test Style | Speedup |\n")
-	fmt.Fprintf(file, "|------|-----------|------------|----------|\n")
+	fmt.Fprintf(file, "| Rank | Benchmark | Test Style | Labels | System | Speedup vs %s |\n", baseline)
+	fmt.Fprintf(file, "|------|-----------|------------|--------|--------|----------------|\n")
 
 	for i := 0; i < 10 && i < len(allSpeedups); i++ {
-		fmt.Fprintf(file, "| %d | %s | %s | %.2fx |\n",
-			i+1, allSpeedups[i].Name, allSpeedups[i].Style, allSpeedups[i].Speedup)
+		s := allSpeedups[i]
+		fmt.Fprintf(file, "| %d | %s | %s | %s | %s | %.2fx |\n", i+1, s.Name, s.Style, s.Labels, s.System, s.Speedup)
+	}
+}
+
+// writeOutlierReport writes a table of benchmarks that had at
+// least one IQR-rule outlier, across all test styles, so a "win"
+// that is actually caused by a single skewed run is visible.
+func writeOutlierReport(file *os.File, results map[string]*AnalysisResult, variantOrder []string, systemNames []string) {
+	any := false
+	for _, style := range variantOrder {
+		if r, ok := results[style]; ok && len(r.OutlierReports) > 0 {
+			any = true
+			break
+		}
+	}
+	if !any {
+		fmt.Fprintf(file, "No outliers detected under the IQR rule.\n")
+		return
+	}
+
+	fmt.Fprintf(file, "| Test Style | Benchmark | Mild (%s) | Severe (%s) |\n",
+		strings.Join(systemNames, "/"), strings.Join(systemNames, "/"))
+	fmt.Fprintf(file, "|------------|-----------|-----------|-----------|\n")
+
+	for _, style := range variantOrder {
+		r, ok := results[style]
+		if !ok {
+			continue
+		}
+		for _, o := range r.OutlierReports {
+			mildParts := make([]string, 0, len(systemNames))
+			severeParts := make([]string, 0, len(systemNames))
+			for _, sys := range systemNames {
+				mildParts = append(mildParts, fmt.Sprintf("%d", o.Mild[sys]))
+				severeParts = append(severeParts, fmt.Sprintf("%d", o.Severe[sys]))
+			}
+			fmt.Fprintf(file, "| %s | %s | %s | %s |\n",
+				style, o.Name, strings.Join(mildParts, "/"), strings.Join(severeParts, "/"))
+		}
 	}
 }
 
@@ -632,6 +1376,270 @@ func median(nums []float64) float64 {
 	return sorted[mid]
 }
 
+// geomean returns the geometric mean of ratios: the nth root of
+// their product, computed as exp(mean(log(ratios))) for numerical
+// stability. Geomean is the standard summary statistic for ratio
+// data such as speedups, since it is unaffected by which side of
+// the ratio is chosen as the baseline.
+func geomean(ratios []float64) float64 {
+	if len(ratios) == 0 {
+		return 0
+	}
+	var sumLog float64
+	for _, r := range ratios {
+		sumLog += math.Log(r)
+	}
+	return math.Exp(sumLog / float64(len(ratios)))
+}
+
+// normalCDF returns P(Z <= z) for a standard normal random variable.
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// mannWhitneyPValue computes a two-sided p-value for the
+// Mann-Whitney U test comparing samples x and y, using the normal
+// approximation with a tie correction. Returns 1 (no evidence of a
+// difference) when either sample is empty.
+func mannWhitneyPValue(x, y []float64) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	type ranked struct {
+		value float64
+		group int // 0 = x, 1 = y
+	}
+	pooled := make([]ranked, 0, n1+n2)
+	for _, v := range x {
+		pooled = append(pooled, ranked{v, 0})
+	}
+	for _, v := range y {
+		pooled = append(pooled, ranked{v, 1})
+	}
+	sort.Slice(pooled, func(i, j int) bool { return pooled[i].value < pooled[j].value })
+
+	ranks := make([]float64, len(pooled))
+	tieCorrection := 0.0
+	i := 0
+	for i < len(pooled) {
+		j := i
+		for j+1 < len(pooled) && pooled[j+1].value == pooled[i].value {
+			j++
+		}
+		avgRank := float64(i+j)/2 + 1 // ranks are 1-indexed
+		for k := i; k <= j; k++ {
+			ranks[k] = avgRank
+		}
+		tieCount := float64(j - i + 1)
+		tieCorrection += tieCount*tieCount*tieCount - tieCount
+		i = j + 1
+	}
+
+	r1 := 0.0
+	for idx, p := range pooled {
+		if p.group == 0 {
+			r1 += ranks[idx]
+		}
+	}
+
+	nf1, nf2 := float64(n1), float64(n2)
+	u1 := r1 - nf1*(nf1+1)/2
+	u2 := nf1*nf2 - u1
+	u := math.Min(u1, u2)
+
+	n := nf1 + nf2
+	meanU := nf1 * nf2 / 2
+	varU := nf1 * nf2 * (n + 1) / 12
+	if n > 1 {
+		varU -= nf1 * nf2 * tieCorrection / (12 * n * (n - 1))
+	}
+	if varU <= 0 {
+		return 1
+	}
+
+	z := (u - meanU) / math.Sqrt(varU)
+	p := 2 * normalCDF(-math.Abs(z))
+	return math.Min(p, 1)
+}
+
+// sampleVariance returns the unbiased sample variance of xs around
+// the given mean.
+func sampleVariance(xs []float64, mean float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	sum := 0.0
+	for _, x := range xs {
+		d := x - mean
+		sum += d * d
+	}
+	return sum / float64(len(xs)-1)
+}
+
+// welchTTestPValue computes a two-sided p-value for Welch's t-test
+// comparing samples x and y, which does not assume equal variances.
+// Returns 1 when either sample has fewer than 2 observations.
+func welchTTestPValue(x, y []float64) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 < 2 || n2 < 2 {
+		return 1
+	}
+
+	m1, m2 := average(x), average(y)
+	v1, v2 := sampleVariance(x, m1), sampleVariance(y, m2)
+	nf1, nf2 := float64(n1), float64(n2)
+
+	se2 := v1/nf1 + v2/nf2
+	if se2 <= 0 {
+		// Zero variance in both samples is the most certain kind of
+		// difference, not the least: every value agreed within each
+		// group, so any nonzero gap between the means is maximally
+		// significant. Only a genuine tie (equal means) is "no
+		// difference".
+		if m1 == m2 {
+			return 1
+		}
+		return 0
+	}
+	t := (m1 - m2) / math.Sqrt(se2)
+
+	df := se2 * se2 / ((v1/nf1)*(v1/nf1)/(nf1-1) + (v2/nf2)*(v2/nf2)/(nf2-1))
+
+	return studentTPValue(t, df)
+}
+
+// studentTPValue returns the two-sided p-value P(|T| >= |t|) for a
+// Student's t distribution with df degrees of freedom.
+func studentTPValue(t, df float64) float64 {
+	return betai(df/2, 0.5, df/(df+t*t))
+}
+
+// betai returns the regularized incomplete beta function I_x(a, b),
+// used here to evaluate the Student's t CDF (Numerical Recipes
+// algorithm, continued-fraction evaluation via betacf).
+func betai(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lnBeta, _ := math.Lgamma(a + b)
+	lnA, _ := math.Lgamma(a)
+	lnB, _ := math.Lgamma(b)
+	bt := math.Exp(lnBeta - lnA - lnB + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return bt * betacf(a, b, x) / a
+	}
+	return 1 - bt*betacf(b, a, 1-x)/b
+}
+
+// betacf evaluates the continued fraction for the incomplete beta
+// function using the modified Lentz algorithm.
+func betacf(a, b, x float64) float64 {
+	const maxIter = 200
+	const eps = 3e-14
+	const fpmin = 1e-300
+
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < fpmin {
+		d = fpmin
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIter; m++ {
+		mf := float64(m)
+		m2 := 2 * mf
+
+		aa := mf * (b - mf) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < fpmin {
+			d = fpmin
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < fpmin {
+			c = fpmin
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + mf) * (qab + mf) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < fpmin {
+			d = fpmin
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < fpmin {
+			c = fpmin
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return h
+}
+
+// labelString renders a benchmark's split-key labels as a sorted,
+// comma-separated "key=value" list for CSV/markdown output, or ""
+// if no split keys are configured.
+func labelString(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+labels[k])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// detailedCSVFilename derives the per-variant comparison CSV
+// filename from its display name, e.g. "Quick" produces the
+// tool's historical "go_benchmark_QUICK_comparison.csv" and a
+// split variant like "Quick [pkg=foo, goos=linux]" produces
+// "go_benchmark_QUICK_PKG_FOO_GOOS_LINUX_comparison.csv".
+func detailedCSVFilename(variant string) string {
+	return fmt.Sprintf("go_benchmark_%s_comparison.csv", slugify(variant))
+}
+
+// slugify uppercases s and collapses every run of non-alphanumeric
+// characters into a single underscore, trimming leading/trailing
+// underscores.
+func slugify(s string) string {
+	var b strings.Builder
+	lastUnderscore := false
+	for _, r := range strings.ToUpper(s) {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastUnderscore = false
+		case !lastUnderscore:
+			b.WriteByte('_')
+			lastUnderscore = true
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
+
 func filterByCategory(results []BenchmarkResult, keywords []string) []BenchmarkResult {
 	var filtered []BenchmarkResult
 	for _, r := range results {
@@ -645,12 +1653,16 @@ func filterByCategory(results []BenchmarkResult, keywords []string) []BenchmarkR
 	return filtered
 }
 
-func calculateAvgSpeedup(results []BenchmarkResult) float64 {
+// calculateAvgSpeedup returns the average speedup of system over
+// baseline across results, counting only benchmarks where system
+// beat baseline.
+func calculateAvgSpeedup(results []BenchmarkResult, baseline, system string) float64 {
 	var speedups []float64
 	for _, r := range results {
-		if r.DebianValue > 0 && r.IYAValue > 0 && r.IYAValue < r.DebianValue {
-			speedup := r.DebianValue / r.IYAValue
-			speedups = append(speedups, speedup)
+		baseValue := r.Values[baseline].NsOp
+		sysValue := r.Values[system].NsOp
+		if baseValue > 0 && sysValue > 0 && sysValue < baseValue {
+			speedups = append(speedups, baseValue/sysValue)
 		}
 	}
 	if len(speedups) == 0 {
@@ -660,26 +1672,17 @@ func calculateAvgSpeedup(results []BenchmarkResult) float64 {
 }
 
 // exportDetailedCSVFiles creates detailed CSV comparison files for each test style
-func exportDetailedCSVFiles(allBenchmarks map[string][]BenchmarkResult) {
-	testStyles := []struct {
-		name     string
-		filename string
-	}{
-		{"Quick", "go_benchmark_QUICK_comparison.csv"},
-		{"Standard", "go_benchmark_STANDARD_comparison.csv"},
-		{"Extended", "go_benchmark_EXTENDED_comparison.csv"},
-		{"Profiled", "go_benchmark_PROFILED_comparison.csv"},
-	}
-
-	for _, style := range testStyles {
-		benchmarks, exists := allBenchmarks[style.name]
+func exportDetailedCSVFiles(allBenchmarks map[string][]BenchmarkResult, variantOrder []string, systemNames []string, baseline string) {
+	for _, variant := range variantOrder {
+		benchmarks, exists := allBenchmarks[variant]
 		if !exists || len(benchmarks) == 0 {
 			continue
 		}
 
-		file, err := os.Create(style.filename)
+		filename := detailedCSVFilename(variant)
+		file, err := os.Create(filename)
 		if err != nil {
-			fmt.Printf("   ❌ Error creating %s: %v\n", style.filename, err)
+			fmt.Printf("   ❌ Error creating %s: %v\n", filename, err)
 			continue
 		}
 		defer file.Close()
@@ -687,86 +1690,440 @@ func exportDetailedCSVFiles(allBenchmarks map[string][]BenchmarkResult) {
 		writer := csv.NewWriter(file)
 		defer writer.Flush()
 
+		labelKeys := make([]string, 0, len(benchmarks[0].Labels))
+		for k := range benchmarks[0].Labels {
+			labelKeys = append(labelKeys, k)
+		}
+		sort.Strings(labelKeys)
+
 		// Write header
-		header := []string{
-			"Benchmark Name",
-			"Debian 11 (ns/op)",
-			"IYA Linux 0.5.0 (ns/op)",
-			"RHEL 10.0 (ns/op)",
-			"Best Performance (ns/op)",
-			"IYA vs Debian Speedup",
-			"IYA vs RHEL Speedup",
-			"Debian 11 (B/op)",
-			"IYA Linux 0.5.0 (B/op)",
-			"RHEL 10.0 (B/op)",
-			"Best Performance (B/op)",
-			"Debian 11 (allocs/op)",
-			"IYA Linux 0.5.0 (allocs/op)",
-			"RHEL 10.0 (allocs/op)",
-			"Best Performance (allocs/op)",
+		header := []string{"Benchmark Name"}
+		for _, k := range labelKeys {
+			header = append(header, k)
+		}
+		for _, sys := range systemNames {
+			header = append(header, fmt.Sprintf("%s (ns/op)", sys))
 		}
+		header = append(header, "Best Performance (ns/op)")
+		for _, sys := range systemNames {
+			header = append(header, fmt.Sprintf("%s (95%% CI)", sys))
+		}
+		for _, sys := range systemNames {
+			if sys == baseline {
+				continue
+			}
+			header = append(header, fmt.Sprintf("%s vs %s Speedup", sys, baseline))
+		}
+		for _, sys := range systemNames {
+			header = append(header, fmt.Sprintf("%s (B/op)", sys))
+		}
+		header = append(header, "Best Performance (B/op)")
+		for _, sys := range systemNames {
+			header = append(header, fmt.Sprintf("%s (allocs/op)", sys))
+		}
+		header = append(header, "Best Performance (allocs/op)")
 		writer.Write(header)
 
 		// Write benchmark data
 		for _, b := range benchmarks {
-			var speedupVsDebian, speedupVsRHEL string
-
-			if b.DebianValue > 0 && b.IYAValue > 0 {
-				speedup := b.DebianValue / b.IYAValue
-				speedupVsDebian = fmt.Sprintf("%.2fx", speedup)
-			} else {
-				speedupVsDebian = "N/A"
+			row := []string{b.Name}
+			for _, k := range labelKeys {
+				row = append(row, b.Labels[k])
+			}
+			for _, sys := range systemNames {
+				row = append(row, fmt.Sprintf("%.2f", b.Values[sys].NsOp))
+			}
+			row = append(row, b.BestSystem)
+			for _, sys := range systemNames {
+				m := b.Values[sys]
+				if m.CILow == 0 && m.CIHigh == 0 {
+					row = append(row, "N/A")
+				} else {
+					row = append(row, fmt.Sprintf("[%.2f, %.2f]", m.CILow, m.CIHigh))
+				}
 			}
 
-			if b.RHELValue > 0 && b.IYAValue > 0 {
-				speedup := b.RHELValue / b.IYAValue
-				speedupVsRHEL = fmt.Sprintf("%.2fx", speedup)
-			} else {
-				speedupVsRHEL = "N/A"
+			baseValue := b.Values[baseline].NsOp
+			for _, sys := range systemNames {
+				if sys == baseline {
+					continue
+				}
+				sysValue := b.Values[sys].NsOp
+
+				var speedupStr string
+				switch {
+				case baseValue <= 0 || sysValue <= 0:
+					speedupStr = "N/A"
+				case *deltaTest != "none" && b.PValue[sys] > significanceThreshold:
+					speedupStr = "~"
+				default:
+					speedupStr = fmt.Sprintf("%.2fx", baseValue/sysValue)
+				}
+				row = append(row, speedupStr)
 			}
 
 			// Determine best for B/op (lowest is best)
-			bestBytes := "--"
-			minBytes := b.DebianBytesPerOp
-			if b.IYABytesPerOp < minBytes {
-				bestBytes = "IYA"
-				minBytes = b.IYABytesPerOp
+			bestBytes := systemNames[0]
+			minBytes := b.Values[systemNames[0]].BytesPerOp
+			for _, sys := range systemNames[1:] {
+				if v := b.Values[sys].BytesPerOp; v < minBytes {
+					minBytes = v
+					bestBytes = sys
+				}
 			}
-			if b.RHELBytesPerOp < minBytes {
-				bestBytes = "RHEL"
+			for _, sys := range systemNames {
+				row = append(row, fmt.Sprintf("%.0f", b.Values[sys].BytesPerOp))
 			}
+			row = append(row, bestBytes)
 
 			// Determine best for allocs/op (lowest is best)
-			bestAllocs := "--"
-			minAllocs := b.DebianAllocsPerOp
-			if b.IYAAllocsPerOp < minAllocs {
-				bestAllocs = "IYA"
-				minAllocs = b.IYAAllocsPerOp
-			}
-			if b.RHELAllocsPerOp < minAllocs {
-				bestAllocs = "RHEL"
-			}
-
-			row := []string{
-				b.Name,
-				fmt.Sprintf("%.2f", b.DebianValue),
-				fmt.Sprintf("%.2f", b.IYAValue),
-				fmt.Sprintf("%.2f", b.RHELValue),
-				b.BestPerformance,
-				speedupVsDebian,
-				speedupVsRHEL,
-				fmt.Sprintf("%.0f", b.DebianBytesPerOp),
-				fmt.Sprintf("%.0f", b.IYABytesPerOp),
-				fmt.Sprintf("%.0f", b.RHELBytesPerOp),
-				bestBytes,
-				fmt.Sprintf("%.0f", b.DebianAllocsPerOp),
-				fmt.Sprintf("%.0f", b.IYAAllocsPerOp),
-				fmt.Sprintf("%.0f", b.RHELAllocsPerOp),
-				bestAllocs,
+			bestAllocs := systemNames[0]
+			minAllocs := b.Values[systemNames[0]].AllocsPerOp
+			for _, sys := range systemNames[1:] {
+				if v := b.Values[sys].AllocsPerOp; v < minAllocs {
+					minAllocs = v
+					bestAllocs = sys
+				}
+			}
+			for _, sys := range systemNames {
+				row = append(row, fmt.Sprintf("%.0f", b.Values[sys].AllocsPerOp))
 			}
+			row = append(row, bestAllocs)
+
 			writer.Write(row)
 		}
 
-		fmt.Printf("   ✅ Created %s (%d benchmarks)\n", style.filename, len(benchmarks))
+		fmt.Printf("   ✅ Created %s (%d benchmarks)\n", filename, len(benchmarks))
+	}
+}
+
+// htmlCell is one system's data for one benchmark row in the HTML
+// report: the formatted ns/op/B/op/allocs/op values, the speedup
+// and p-value against the baseline (blank for the baseline column
+// itself), and an inline SVG box-plot of that system's samples.
+type htmlCell struct {
+	System  string
+	NsOp    string
+	CI      string
+	Bytes   string
+	Allocs  string
+	Speedup string
+	PValue  string
+	Best    bool
+	Box     template.HTML
+}
+
+// htmlRow is one benchmark's full row in an HTML report table.
+type htmlRow struct {
+	Name   string
+	Labels string
+	Cells  []htmlCell
+}
+
+// htmlSpeedup summarizes one non-baseline system's speedup over the
+// baseline, in both arithmetic- and geometric-mean form. Present is
+// false when the system had no qualifying speedup to summarize; a
+// row of category speedups always includes one entry per non-
+// baseline system (Present or not) so its cells line up positionally
+// with the fixed NonBaselineSystems header.
+type htmlSpeedup struct {
+	System  string
+	Avg     float64
+	Geomean float64
+	Present bool
+}
+
+// htmlWinCount is one system's outright-win tally.
+type htmlWinCount struct {
+	System string
+	Count  int
+	Pct    float64
+}
+
+// htmlVariantSection is the per-test-style-variant section of the
+// HTML report: its win counts, speedup summary, and full benchmark
+// table.
+type htmlVariantSection struct {
+	Name     string
+	Total    int
+	Wins     []htmlWinCount
+	Speedups []htmlSpeedup
+	Rows     []htmlRow
+}
+
+// htmlCategoryRow is one benchmark category's pooled speedup
+// summary across every variant, mirroring generateCategoryAnalysis.
+type htmlCategoryRow struct {
+	Category string
+	Speedups []htmlSpeedup
+}
+
+// htmlReportData is the root data passed to htmlReportTemplate.
+type htmlReportData struct {
+	Baseline           string
+	Systems            []string
+	NonBaselineSystems []string
+	TotalTests         int
+	OverallWins        []htmlWinCount
+	Overall            []htmlSpeedup
+	Categories         []htmlCategoryRow
+	Variants           []htmlVariantSection
+}
+
+// svgBoxPlot renders a compact inline SVG box-and-whisker plot of
+// samples: a whisker line spanning min-max, a box spanning Q1-Q3,
+// and a tick at the median. Returns a blank placeholder when there
+// are too few samples to summarize (e.g. a single-run test style).
+func svgBoxPlot(samples []float64) template.HTML {
+	if len(samples) < 2 {
+		return `<svg width="120" height="24"></svg>`
+	}
+
+	sorted := make([]float64, len(samples))
+	copy(sorted, samples)
+	sort.Float64s(sorted)
+
+	lo, hi := sorted[0], sorted[len(sorted)-1]
+	if hi == lo {
+		hi = lo + 1
+	}
+	scale := func(v float64) float64 { return 4 + (v-lo)/(hi-lo)*112 }
+
+	q1 := scale(percentileInterp(sorted, 0.25))
+	q3 := scale(percentileInterp(sorted, 0.75))
+	med := scale(percentileInterp(sorted, 0.5))
+	minX := scale(lo)
+	maxX := scale(hi)
+
+	return template.HTML(fmt.Sprintf(
+		`<svg width="120" height="24" viewBox="0 0 120 24">`+
+			`<line x1="%.1f" y1="12" x2="%.1f" y2="12" stroke="#888"/>`+
+			`<rect x="%.1f" y="4" width="%.1f" height="16" fill="#cfe8ff" stroke="#3366aa"/>`+
+			`<line x1="%.1f" y1="4" x2="%.1f" y2="20" stroke="#3366aa" stroke-width="2"/>`+
+			`</svg>`,
+		minX, maxX, math.Min(q1, q3), math.Abs(q3-q1), med, med,
+	))
+}
+
+// htmlReportTemplate renders htmlReportData into a self-contained
+// HTML page: no external stylesheets, scripts, or fonts, so the
+// file can be committed to a repo or opened directly from disk. The
+// inline script adds click-to-sort to every ".sortable" table.
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Benchmark Analysis Report</title>
+<style>
+  body { font-family: sans-serif; margin: 2em; color: #222; }
+  h1, h2, h3 { color: #113355; }
+  table { border-collapse: collapse; margin-bottom: 2em; }
+  th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: right; font-size: 0.9em; }
+  th:first-child, td:first-child { text-align: left; }
+  th { background: #eef3f8; cursor: pointer; }
+  td.best { background: #e3f7e3; font-weight: bold; }
+  .summary-box { display: inline-block; margin: 0 1.5em 1em 0; padding: 0.5em 1em; background: #f6f8fa; border: 1px solid #ddd; }
+</style>
+</head>
+<body>
+<h1>Benchmark Analysis Report</h1>
+<p>Systems compared: {{range $i, $s := .Systems}}{{if $i}}, {{end}}{{$s}}{{end}} (baseline: {{.Baseline}})</p>
+
+<h2>Executive Summary</h2>
+<div class="summary-box">Total benchmarks: {{.TotalTests}}</div>
+{{range .OverallWins}}<div class="summary-box">{{.System}} wins: {{.Count}} ({{printf "%.1f" .Pct}}%)</div>
+{{end}}
+{{range .Overall}}<div class="summary-box">{{.System}} avg speedup: {{printf "%.2fx" .Avg}} (geomean {{printf "%.2fx" .Geomean}})</div>
+{{end}}
+
+<h2>Category Breakdown</h2>
+<table class="sortable">
+<tr><th>Category</th>{{range $.NonBaselineSystems}}<th>{{.}} vs baseline</th>{{end}}</tr>
+{{range .Categories}}<tr><td>{{.Category}}</td>{{range .Speedups}}<td>{{if .Present}}{{printf "%.2fx" .Avg}} (geomean {{printf "%.2fx" .Geomean}}){{else}}N/A{{end}}</td>{{end}}</tr>
+{{end}}
+</table>
+
+{{range .Variants}}
+<h2>{{.Name}}</h2>
+<p>Total tests: {{.Total}}</p>
+{{range .Wins}}<div class="summary-box">{{.System}} wins: {{.Count}} ({{printf "%.1f" .Pct}}%)</div>
+{{end}}
+{{range .Speedups}}<div class="summary-box">{{.System}} avg speedup: {{printf "%.2fx" .Avg}} (geomean {{printf "%.2fx" .Geomean}})</div>
+{{end}}
+<table class="sortable">
+<tr><th>Benchmark</th><th>Labels</th>{{range $.Systems}}<th colspan="2">{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>
+<td>{{.Name}}</td><td>{{.Labels}}</td>
+{{range .Cells}}<td class="{{if .Best}}best{{end}}">{{.NsOp}} ns/op<br>CI {{.CI}}<br>{{.Bytes}} B/op, {{.Allocs}} allocs/op{{if .Speedup}}<br>{{.Speedup}}{{if .PValue}} (p={{.PValue}}){{end}}{{end}}</td><td>{{.Box}}</td>
+{{end}}
+</tr>
+{{end}}
+</table>
+{{end}}
+
+<script>
+document.querySelectorAll("table.sortable").forEach(function(table) {
+  table.querySelectorAll("th").forEach(function(th, col) {
+    th.addEventListener("click", function() {
+      var rows = Array.prototype.slice.call(table.querySelectorAll("tr")).slice(1);
+      var asc = table.getAttribute("data-sort-col") != col || table.getAttribute("data-sort-dir") != "asc";
+      rows.sort(function(a, b) {
+        var av = a.cells[col] ? a.cells[col].innerText : "";
+        var bv = b.cells[col] ? b.cells[col].innerText : "";
+        var an = parseFloat(av), bn = parseFloat(bv);
+        var cmp = (!isNaN(an) && !isNaN(bn)) ? an - bn : av.localeCompare(bv);
+        return asc ? cmp : -cmp;
+      });
+      rows.forEach(function(r) { table.appendChild(r); });
+      table.setAttribute("data-sort-col", col);
+      table.setAttribute("data-sort-dir", asc ? "asc" : "desc");
+    });
+  });
+});
+</script>
+</body>
+</html>
+`))
+
+// exportHTMLReport renders a self-contained HTML report (sortable
+// tables, inline SVG box-plots of per-iteration samples, and a
+// summary of geomean speedups, win counts, and category breakdowns)
+// to path, for reviewers without a markdown or CSV viewer.
+func exportHTMLReport(path string, allBenchmarks map[string][]BenchmarkResult, allResults map[string]*AnalysisResult, variantOrder []string, systemNames []string, baseline string) {
+	data := htmlReportData{
+		Baseline: baseline,
+		Systems:  systemNames,
+	}
+	for _, sys := range systemNames {
+		if sys != baseline {
+			data.NonBaselineSystems = append(data.NonBaselineSystems, sys)
+		}
+	}
+
+	overallGeomeans := make(map[string][]float64)
+	totalWins := make(map[string]int)
+	for _, r := range allResults {
+		data.TotalTests += r.TotalBenchmarks
+		for _, sys := range systemNames {
+			totalWins[sys] += r.Wins[sys]
+			if sys == baseline {
+				continue
+			}
+			if r.GeomeanSpeedup[sys] > 0 {
+				overallGeomeans[sys] = append(overallGeomeans[sys], r.GeomeanSpeedup[sys])
+			}
+		}
+	}
+	for _, sys := range systemNames {
+		pct := 0.0
+		if data.TotalTests > 0 {
+			pct = float64(totalWins[sys]) / float64(data.TotalTests) * 100
+		}
+		data.OverallWins = append(data.OverallWins, htmlWinCount{System: sys, Count: totalWins[sys], Pct: pct})
+		if sys == baseline {
+			continue
+		}
+		if g := overallGeomeans[sys]; len(g) > 0 {
+			data.Overall = append(data.Overall, htmlSpeedup{System: sys, Avg: average(g), Geomean: geomean(g)})
+		}
+	}
+
+	catNames := make([]string, 0, len(benchmarkCategories))
+	for cat := range benchmarkCategories {
+		catNames = append(catNames, cat)
+	}
+	sort.Strings(catNames)
+	for _, cat := range catNames {
+		keywords := benchmarkCategories[cat]
+		row := htmlCategoryRow{Category: cat}
+		anyData := false
+		for _, sys := range data.NonBaselineSystems {
+			var speedups []float64
+			for _, results := range allBenchmarks {
+				catResults := filterByCategory(results, keywords)
+				for _, b := range catResults {
+					baseValue := b.Values[baseline].NsOp
+					sysValue := b.Values[sys].NsOp
+					if baseValue > 0 && sysValue > 0 && sysValue < baseValue {
+						speedups = append(speedups, baseValue/sysValue)
+					}
+				}
+			}
+			// Always append one entry per non-baseline system, even
+			// with no qualifying speedup, so row cells line up
+			// positionally with the NonBaselineSystems header.
+			if len(speedups) > 0 {
+				anyData = true
+				row.Speedups = append(row.Speedups, htmlSpeedup{System: sys, Avg: average(speedups), Geomean: geomean(speedups), Present: true})
+			} else {
+				row.Speedups = append(row.Speedups, htmlSpeedup{System: sys})
+			}
+		}
+		if anyData {
+			data.Categories = append(data.Categories, row)
+		}
+	}
+
+	for _, variant := range variantOrder {
+		r, ok := allResults[variant]
+		if !ok {
+			continue
+		}
+		section := htmlVariantSection{Name: variant, Total: r.TotalBenchmarks}
+		for _, sys := range systemNames {
+			pct := 0.0
+			if r.TotalBenchmarks > 0 {
+				pct = float64(r.Wins[sys]) / float64(r.TotalBenchmarks) * 100
+			}
+			section.Wins = append(section.Wins, htmlWinCount{System: sys, Count: r.Wins[sys], Pct: pct})
+			if sys == baseline {
+				continue
+			}
+			if r.AvgSpeedup[sys] > 0 {
+				section.Speedups = append(section.Speedups, htmlSpeedup{System: sys, Avg: r.AvgSpeedup[sys], Geomean: r.GeomeanSpeedup[sys]})
+			}
+		}
+
+		for _, b := range allBenchmarks[variant] {
+			row := htmlRow{Name: b.Name, Labels: labelString(b.Labels)}
+			baseValue := b.Values[baseline].NsOp
+			for _, sys := range systemNames {
+				m := b.Values[sys]
+				cell := htmlCell{
+					System: sys,
+					NsOp:   fmt.Sprintf("%.2f", m.NsOp),
+					Bytes:  fmt.Sprintf("%.0f", m.BytesPerOp),
+					Allocs: fmt.Sprintf("%.0f", m.AllocsPerOp),
+					Best:   sys == b.BestSystem,
+					Box:    svgBoxPlot(m.Samples),
+				}
+				if m.CILow == 0 && m.CIHigh == 0 {
+					cell.CI = "N/A"
+				} else {
+					cell.CI = fmt.Sprintf("[%.2f, %.2f]", m.CILow, m.CIHigh)
+				}
+				if sys != baseline && baseValue > 0 && m.NsOp > 0 {
+					cell.Speedup = fmt.Sprintf("%.2fx", baseValue/m.NsOp)
+					if b.SignificanceTest != "none" {
+						cell.PValue = fmt.Sprintf("%.3f", b.PValue[sys])
+					}
+				}
+				row.Cells = append(row.Cells, cell)
+			}
+			section.Rows = append(section.Rows, row)
+		}
+
+		data.Variants = append(data.Variants, section)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		fmt.Printf("   ❌ Error creating %s: %v\n", path, err)
+		return
+	}
+	defer file.Close()
+
+	if err := htmlReportTemplate.Execute(file, data); err != nil {
+		fmt.Printf("   ❌ Error rendering %s: %v\n", path, err)
 	}
 }
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// approxEqual reports whether a and b agree to within tol, which is
+// generous enough to absorb floating-point differences between this
+// package's iterative numerics and the independently computed
+// reference values below.
+func approxEqual(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func TestMannWhitneyPValue(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		want float64
+	}{
+		{
+			name: "clearly separated",
+			x:    []float64{1, 2, 3},
+			y:    []float64{4, 5, 6},
+			want: 0.04953461343562674,
+		},
+		{
+			name: "larger separated samples",
+			x:    []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			y:    []float64{2, 4, 6, 8, 10, 12, 14, 16, 18, 20},
+			want: 0.03727646320484933,
+		},
+		{
+			name: "identical distributions",
+			x:    []float64{5, 5, 5, 5},
+			y:    []float64{5, 5, 5, 5},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mannWhitneyPValue(tt.x, tt.y)
+			if !approxEqual(got, tt.want, 1e-6) {
+				t.Errorf("mannWhitneyPValue(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWelchTTestPValue(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		want float64
+	}{
+		{
+			name: "clearly separated",
+			x:    []float64{10, 12, 14, 16, 18},
+			y:    []float64{20, 22, 24, 26, 28},
+			want: 0.0010528257933665386,
+		},
+		{
+			name: "identical zero-variance samples",
+			x:    []float64{7, 7, 7},
+			y:    []float64{7, 7, 7},
+			want: 1,
+		},
+		{
+			name: "different zero-variance samples",
+			x:    []float64{100, 100, 100},
+			y:    []float64{200, 200, 200},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := welchTTestPValue(tt.x, tt.y)
+			if !approxEqual(got, tt.want, 1e-6) {
+				t.Errorf("welchTTestPValue(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIQROutliers(t *testing.T) {
+	samples := []float64{10, 11, 9, 10, 12, 11, 10, 9, 13, 100}
+
+	kept, mild, severe := iqrOutliers(samples)
+
+	wantKept := []float64{10, 11, 9, 10, 12, 11, 10, 9, 13}
+	if !reflect.DeepEqual(kept, wantKept) {
+		t.Errorf("kept = %v, want %v", kept, wantKept)
+	}
+	if mild != 0 {
+		t.Errorf("mild = %d, want 0", mild)
+	}
+	if severe != 1 {
+		t.Errorf("severe = %d, want 1", severe)
+	}
+}
+
+func TestIQROutliersTooFewSamples(t *testing.T) {
+	samples := []float64{1, 2, 3}
+
+	kept, mild, severe := iqrOutliers(samples)
+
+	if !reflect.DeepEqual(kept, samples) {
+		t.Errorf("kept = %v, want samples returned untouched", kept)
+	}
+	if mild != 0 || severe != 0 {
+		t.Errorf("mild=%d severe=%d, want 0, 0", mild, severe)
+	}
+}
+
+func TestGoBenchParserParse(t *testing.T) {
+	input := `pkg: foo/bar
+goos: linux
+BenchmarkA-4   1000000   100 ns/op   16 B/op   2 allocs/op
+pkg: baz/qux
+goos: linux
+BenchmarkB-4   2000000   200 ns/op
+`
+	samples, err := goBenchParser{}.Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(samples) != 2 {
+		t.Fatalf("len(samples) = %d, want 2", len(samples))
+	}
+
+	a, b := samples[0], samples[1]
+
+	if a.Name != "BenchmarkA" || a.NsOp != 100 || !a.HasBytesPerOp || a.BytesPerOp != 16 || !a.HasAllocsPerOp || a.AllocsPerOp != 2 {
+		t.Errorf("samples[0] = %+v, want BenchmarkA with ns/op=100, 16 B/op, 2 allocs/op", a)
+	}
+	wantLabelsA := map[string]string{"pkg": "foo/bar", "goos": "linux"}
+	if !reflect.DeepEqual(a.Labels, wantLabelsA) {
+		t.Errorf("samples[0].Labels = %v, want %v", a.Labels, wantLabelsA)
+	}
+
+	if b.Name != "BenchmarkB" || b.NsOp != 200 || b.HasBytesPerOp || b.HasAllocsPerOp {
+		t.Errorf("samples[1] = %+v, want BenchmarkB with ns/op=200, no B/op or allocs/op", b)
+	}
+	wantLabelsB := map[string]string{"pkg": "baz/qux", "goos": "linux"}
+	if !reflect.DeepEqual(b.Labels, wantLabelsB) {
+		t.Errorf("samples[1].Labels = %v, want %v", b.Labels, wantLabelsB)
+	}
+
+	// The second header block must not retroactively rewrite the
+	// first sample's labels.
+	if reflect.DeepEqual(a.Labels, b.Labels) {
+		t.Errorf("samples[0] and samples[1] share the same labels map: %v", a.Labels)
+	}
+}
+
+func TestGoogleBenchmarkParserParse(t *testing.T) {
+	input := `{
+		"context": {"date": "2024-01-01 00:00:00", "num_cpus": 4},
+		"benchmarks": [
+			{"name": "BM_Foo", "real_time": 105, "cpu_time": 100, "time_unit": "ns", "iterations": 1000000},
+			{"name": "BM_Bar", "real_time": 0.08, "cpu_time": 0.05, "time_unit": "us", "iterations": 2000000}
+		]
+	}`
+
+	samples, err := googleBenchmarkParser{}.Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(samples) != 2 {
+		t.Fatalf("len(samples) = %d, want 2", len(samples))
+	}
+
+	wantLabels := map[string]string{"date": "2024-01-01 00:00:00", "num_cpus": "4"}
+
+	if samples[0].Name != "BM_Foo" || samples[0].NsOp != 100 {
+		t.Errorf("samples[0] = %+v, want BM_Foo with ns/op=100", samples[0])
+	}
+	if !reflect.DeepEqual(samples[0].Labels, wantLabels) {
+		t.Errorf("samples[0].Labels = %v, want %v", samples[0].Labels, wantLabels)
+	}
+
+	if samples[1].Name != "BM_Bar" || samples[1].NsOp != 50 {
+		t.Errorf("samples[1] = %+v, want BM_Bar with ns/op=50", samples[1])
+	}
+}
+
+func TestMangoHudParserParse(t *testing.T) {
+	input := "os,cpu,gpu,ram,kernel,driver,cpuscheduler\n" +
+		"Linux,AMD Ryzen,NVIDIA RTX,32GB,6.1.0,535.129,schedutil\n" +
+		"fps,frametime\n" +
+		"50,20\n" +
+		"60,16.67\n" +
+		"70,14.29\n" +
+		"80,12.5\n"
+
+	samples, err := mangoHudParser{}.Parse([]byte(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(samples) != 3 {
+		t.Fatalf("len(samples) = %d, want 3", len(samples))
+	}
+
+	wantLabels := map[string]string{
+		"os": "Linux", "cpu": "AMD Ryzen", "gpu": "NVIDIA RTX",
+		"ram": "32GB", "kernel": "6.1.0", "driver": "535.129", "cpuscheduler": "schedutil",
+	}
+	for _, s := range samples {
+		if !reflect.DeepEqual(s.Labels, wantLabels) {
+			t.Errorf("%s.Labels = %v, want %v", s.Name, s.Labels, wantLabels)
+		}
+	}
+
+	want := map[string]float64{
+		"FPS mean": 15863095.238095237,
+		"FPS p1":   19880715.705765408,
+		"FPS p99":  12547051.442910915,
+	}
+	for _, s := range samples {
+		w, ok := want[s.Name]
+		if !ok {
+			t.Errorf("unexpected sample name %q", s.Name)
+			continue
+		}
+		if !approxEqual(s.NsOp, w, 1e-3) {
+			t.Errorf("%s.NsOp = %v, want %v", s.Name, s.NsOp, w)
+		}
+	}
+}
+
+func TestMangoHudParserParseNoFpsColumn(t *testing.T) {
+	input := "os,cpu,gpu,ram,kernel,driver,cpuscheduler\n" +
+		"Linux,AMD,NVIDIA,32GB,6.1,535,schedutil\n" +
+		"frametime\n" +
+		"16.67\n"
+
+	if _, err := (mangoHudParser{}).Parse([]byte(input)); err == nil {
+		t.Error("Parse() error = nil, want error for missing fps column")
+	}
+}
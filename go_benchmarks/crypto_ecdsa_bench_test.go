@@ -0,0 +1,111 @@
+package benchmark
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+// ============================================================
+// Elliptic-Curve Scalar-Arithmetic and Signature Benchmarks
+//
+// The SHA-256 cases above say nothing about the elliptic-curve
+// operations that dominate signature verification workloads. These
+// benchmarks isolate the hot operations (scalar multiplication,
+// sign, verify, and a batch-verify loop standing in for signature
+// aggregation) after key setup, so only the operation itself is
+// timed. The standard library has no pairing-friendly curve, so
+// unlike a BLS12-381 suite this can't benchmark an actual pairing
+// or true aggregate signature verification; stdlib P-256 via
+// crypto/ecdsa is used instead, keeping this package free of
+// external dependencies like every other file here.
+// ============================================================
+
+func newECDSAKeyPair(b *testing.B) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		b.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+func hashMessage(msg []byte) []byte {
+	h := sha256.Sum256(msg)
+	return h[:]
+}
+
+func BenchmarkECDSAP256ScalarMul(b *testing.B) {
+	curve := elliptic.P256()
+	scalar := newECDSAKeyPair(b).D.Bytes()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		curve.ScalarBaseMult(scalar)
+	}
+}
+
+func BenchmarkECDSAP256Sign(b *testing.B) {
+	key := newECDSAKeyPair(b)
+	hash := hashMessage([]byte("benchmarks-runner ECDSA sign payload"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := ecdsa.Sign(rand.Reader, key, hash); err != nil {
+			b.Fatalf("sign: %v", err)
+		}
+	}
+}
+
+func BenchmarkECDSAP256Verify(b *testing.B) {
+	key := newECDSAKeyPair(b)
+	hash := hashMessage([]byte("benchmarks-runner ECDSA verify payload"))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash)
+	if err != nil {
+		b.Fatalf("sign: %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !ecdsa.Verify(&key.PublicKey, hash, r, s) {
+			b.Fatal("signature failed to verify")
+		}
+	}
+}
+
+// ecdsaSignedMessage is a message signed with its own key pair, for
+// the batch-verify benchmark below.
+type ecdsaSignedMessage struct {
+	key  *ecdsa.PrivateKey
+	hash []byte
+	r, s *big.Int
+}
+
+func BenchmarkECDSAP256BatchVerify(b *testing.B) {
+	for _, n := range []int{10, 100} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			signed := make([]ecdsaSignedMessage, n)
+			for i := 0; i < n; i++ {
+				key := newECDSAKeyPair(b)
+				hash := hashMessage([]byte(fmt.Sprintf("batch-verify payload %d", i)))
+				r, s, err := ecdsa.Sign(rand.Reader, key, hash)
+				if err != nil {
+					b.Fatalf("sign: %v", err)
+				}
+				signed[i] = ecdsaSignedMessage{key: key, hash: hash, r: r, s: s}
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for _, sm := range signed {
+					if !ecdsa.Verify(&sm.key.PublicKey, sm.hash, sm.r, sm.s) {
+						b.Fatal("batch verify failed")
+					}
+				}
+			}
+		})
+	}
+}
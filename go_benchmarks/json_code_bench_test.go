@@ -0,0 +1,118 @@
+package benchmark
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"testing"
+)
+
+// ============================================================
+// Large-Corpus JSON Benchmarks
+//
+// Modeled on Go's own encoding/json benchmark corpus
+// (testdata/code.json.gz): a deeply nested tree of source-tree
+// nodes, several orders of magnitude larger than the fixed
+// ComplexData struct above, so Marshal/Unmarshal cost reflects a
+// realistic payload rather than a handful of fields.
+// ============================================================
+
+type codeNode struct {
+	Name     string      `json:"name"`
+	Kids     []*codeNode `json:"kids"`
+	CLWeight float64     `json:"cl_weight"`
+	Touches  int         `json:"touches"`
+	MinT     int64       `json:"min_t"`
+	MaxT     int64       `json:"max_t"`
+	MeanT    float64     `json:"mean_t"`
+}
+
+type codeResponse struct {
+	Tree     *codeNode `json:"tree"`
+	Username string    `json:"username"`
+}
+
+var (
+	codeOnce sync.Once
+	codeJSON []byte
+	codeData codeResponse
+)
+
+// loadCodeCorpus decompresses testdata/code.json.gz once and
+// unmarshals it into codeData so every benchmark reuses the same
+// in-memory fixture.
+func loadCodeCorpus(b *testing.B) {
+	codeOnce.Do(func() {
+		f, err := os.Open("testdata/code.json.gz")
+		if err != nil {
+			b.Fatalf("open testdata/code.json.gz: %v", err)
+		}
+		defer f.Close()
+
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			b.Fatalf("gzip.NewReader: %v", err)
+		}
+		defer gz.Close()
+
+		codeJSON, err = io.ReadAll(gz)
+		if err != nil {
+			b.Fatalf("read testdata/code.json.gz: %v", err)
+		}
+
+		if err := json.Unmarshal(codeJSON, &codeData); err != nil {
+			b.Fatalf("unmarshal code corpus: %v", err)
+		}
+	})
+}
+
+func BenchmarkCodeMarshal(b *testing.B) {
+	loadCodeCorpus(b)
+	b.SetBytes(int64(len(codeJSON)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(&codeData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCodeUnmarshal(b *testing.B) {
+	loadCodeCorpus(b)
+	b.SetBytes(int64(len(codeJSON)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		if err := json.Unmarshal(codeJSON, &r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCodeEncoder(b *testing.B) {
+	loadCodeCorpus(b)
+	b.SetBytes(int64(len(codeJSON)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		enc := json.NewEncoder(io.Discard)
+		if err := enc.Encode(&codeData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCodeDecoder(b *testing.B) {
+	loadCodeCorpus(b)
+	b.SetBytes(int64(len(codeJSON)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var r codeResponse
+		dec := json.NewDecoder(bytes.NewReader(codeJSON))
+		if err := dec.Decode(&r); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package benchmark
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+// ============================================================
+// Map Hashing Microbenchmarks
+//
+// These isolate the cost of hashing + lookup from allocation and
+// insertion, the way the Go runtime's own map benchmarks do:
+// pre-populate the map once, then in the hot loop only rotate
+// through a pre-built slice of keys. BenchmarkMapOperations* above
+// conflates all three costs into one number; this file lets a
+// hash-function regression show up on its own.
+// ============================================================
+
+const hashSpeedN = 1 << 10
+
+func BenchmarkHashStringSpeed(b *testing.B) {
+	keys := make([]string, hashSpeedN)
+	m := make(map[string]int, hashSpeedN)
+	for i := range keys {
+		keys[i] = "somekey_" + strconv.Itoa(i)
+		m[keys[i]] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[keys[i&(hashSpeedN-1)]]
+	}
+}
+
+func BenchmarkHashBytesSpeed(b *testing.B) {
+	// A [17]byte chunk array lets us vary the start offset mod 16
+	// so unaligned reads are exercised the same way the runtime's
+	// own hash benchmarks do.
+	var chunks [hashSpeedN][17]byte
+	keys := make([]string, hashSpeedN)
+	m := make(map[string]int, hashSpeedN)
+	for i := range chunks {
+		for j := range chunks[i] {
+			chunks[i][j] = byte(i + j)
+		}
+		offset := i % 16
+		keys[i] = string(chunks[i][offset:])
+		m[keys[i]] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[keys[i&(hashSpeedN-1)]]
+	}
+}
+
+func BenchmarkHashInt32Speed(b *testing.B) {
+	keys := make([]int32, hashSpeedN)
+	m := make(map[int32]int, hashSpeedN)
+	for i := range keys {
+		keys[i] = int32(i)
+		m[keys[i]] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[keys[i&(hashSpeedN-1)]]
+	}
+}
+
+func BenchmarkHashInt64Speed(b *testing.B) {
+	keys := make([]int64, hashSpeedN)
+	m := make(map[int64]int, hashSpeedN)
+	for i := range keys {
+		keys[i] = int64(i)
+		m[keys[i]] = i
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[keys[i&(hashSpeedN-1)]]
+	}
+}
+
+var mapSizes = []int{10, 1_000, 100_000, 1_000_000}
+
+func BenchmarkMapGrow(b *testing.B) {
+	for _, size := range mapSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				m := make(map[int]int)
+				for j := 0; j < size; j++ {
+					m[j] = j
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkMapDelete(b *testing.B) {
+	for _, size := range mapSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			keys := make([]int, size)
+			for i := range keys {
+				keys[i] = i
+			}
+
+			// Rebuilding a size-entry map is expensive, so it's
+			// excluded from the timed portion below; but rebuilding
+			// it on every iteration (rather than once per batch of
+			// size deletions) would let the untimed rebuild cost
+			// dominate wall-clock time while the timed cost per
+			// iteration stays tiny, so b.N calibration never
+			// converges. Share one map across a batch of size
+			// deletions instead, rebuilding only when it empties out.
+			var m map[int]int
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if i%size == 0 {
+					b.StopTimer()
+					m = make(map[int]int, size)
+					for _, k := range keys {
+						m[k] = k
+					}
+					b.StartTimer()
+				}
+				delete(m, keys[i%size])
+			}
+		})
+	}
+}
+
+func BenchmarkMapIter(b *testing.B) {
+	for _, size := range mapSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			m := make(map[int]int, size)
+			for j := 0; j < size; j++ {
+				m[j] = j
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				sum := 0
+				for _, v := range m {
+					sum += v
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package benchmark
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// ============================================================
+// Memory-Hierarchy-Aware Matrix Multiply Benchmarks
+//
+// BenchmarkMatrixMultiply50x50/100x100 above use a single
+// naive ijk loop order over [][]int, which conflates algorithmic
+// cost with cache behavior. These benchmarks hold the algorithm
+// fixed at sizes that cross the L1/L2/L3 thresholds and vary loop
+// order, blocking, and parallelism, reporting GFLOPS so the
+// numbers are comparable across sizes.
+// ============================================================
+
+var matrixSizes = []int{128, 256, 512, 1024}
+
+func newFlatMatrix(n int) []float64 {
+	m := make([]float64, n*n)
+	for i := range m {
+		m[i] = rand.Float64()
+	}
+	return m
+}
+
+func reportGFLOPS(b *testing.B, n int, elapsed float64) {
+	flops := 2 * float64(n) * float64(n) * float64(n)
+	b.ReportMetric(flops/elapsed/1e9, "GFLOPS")
+}
+
+// multiplyIJK is the naive i,j,k loop order: for each output cell,
+// walk k across a full row of A and a full column of B.
+func multiplyIJK(a, mat []float64, n int, c []float64) {
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var sum float64
+			for k := 0; k < n; k++ {
+				sum += a[i*n+k] * mat[k*n+j]
+			}
+			c[i*n+j] = sum
+		}
+	}
+}
+
+// multiplyIKJ reorders the loops so the innermost loop walks
+// contiguous rows of both B and C, which is far friendlier to a
+// row-major cache line than the ijk order above.
+func multiplyIKJ(a, mat []float64, n int, c []float64) {
+	for i := 0; i < n; i++ {
+		for k := 0; k < n; k++ {
+			aik := a[i*n+k]
+			for j := 0; j < n; j++ {
+				c[i*n+j] += aik * mat[k*n+j]
+			}
+		}
+	}
+}
+
+// multiplyBlocked tiles the ikj order into BxB blocks so the
+// working set for a tile fits in L1/L2 regardless of matrix size.
+func multiplyBlocked(a, mat []float64, n, blockSize int, c []float64) {
+	for ii := 0; ii < n; ii += blockSize {
+		iEnd := min(ii+blockSize, n)
+		for kk := 0; kk < n; kk += blockSize {
+			kEnd := min(kk+blockSize, n)
+			for jj := 0; jj < n; jj += blockSize {
+				jEnd := min(jj+blockSize, n)
+				for i := ii; i < iEnd; i++ {
+					for k := kk; k < kEnd; k++ {
+						aik := a[i*n+k]
+						for j := jj; j < jEnd; j++ {
+							c[i*n+j] += aik * mat[k*n+j]
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// multiplyParallel splits rows of the output across NumCPU
+// goroutines, each running the cache-friendly ikj order.
+func multiplyParallel(a, mat []float64, n int, c []float64) {
+	workers := runtime.NumCPU()
+	rowsPerWorker := (n + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * rowsPerWorker
+		end := min(start+rowsPerWorker, n)
+		if start >= end {
+			continue
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				for k := 0; k < n; k++ {
+					aik := a[i*n+k]
+					for j := 0; j < n; j++ {
+						c[i*n+j] += aik * mat[k*n+j]
+					}
+				}
+			}
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+func BenchmarkMatrixMultiplyIJK(b *testing.B) {
+	for _, n := range matrixSizes {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			a, mat := newFlatMatrix(n), newFlatMatrix(n)
+			c := make([]float64, n*n)
+			b.ResetTimer()
+			start := b.Elapsed()
+			for i := 0; i < b.N; i++ {
+				multiplyIJK(a, mat, n, c)
+			}
+			reportGFLOPS(b, n, (b.Elapsed()-start).Seconds()/float64(b.N))
+		})
+	}
+}
+
+func BenchmarkMatrixMultiplyIKJ(b *testing.B) {
+	for _, n := range matrixSizes {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			a, mat := newFlatMatrix(n), newFlatMatrix(n)
+			c := make([]float64, n*n)
+			b.ResetTimer()
+			start := b.Elapsed()
+			for i := 0; i < b.N; i++ {
+				for j := range c {
+					c[j] = 0
+				}
+				multiplyIKJ(a, mat, n, c)
+			}
+			reportGFLOPS(b, n, (b.Elapsed()-start).Seconds()/float64(b.N))
+		})
+	}
+}
+
+func BenchmarkMatrixMultiplyBlocked(b *testing.B) {
+	for _, n := range matrixSizes {
+		for _, blockSize := range []int{8, 16, 32, 64} {
+			b.Run(fmt.Sprintf("n=%d/block=%d", n, blockSize), func(b *testing.B) {
+				a, mat := newFlatMatrix(n), newFlatMatrix(n)
+				c := make([]float64, n*n)
+				b.ResetTimer()
+				start := b.Elapsed()
+				for i := 0; i < b.N; i++ {
+					for j := range c {
+						c[j] = 0
+					}
+					multiplyBlocked(a, mat, n, blockSize, c)
+				}
+				reportGFLOPS(b, n, (b.Elapsed()-start).Seconds()/float64(b.N))
+			})
+		}
+	}
+}
+
+func BenchmarkMatrixMultiplyParallel(b *testing.B) {
+	for _, n := range matrixSizes {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			a, mat := newFlatMatrix(n), newFlatMatrix(n)
+			c := make([]float64, n*n)
+			b.ResetTimer()
+			start := b.Elapsed()
+			for i := 0; i < b.N; i++ {
+				for j := range c {
+					c[j] = 0
+				}
+				multiplyParallel(a, mat, n, c)
+			}
+			reportGFLOPS(b, n, (b.Elapsed()-start).Seconds()/float64(b.N))
+		})
+	}
+}
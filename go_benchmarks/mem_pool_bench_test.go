@@ -0,0 +1,131 @@
+package benchmark
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// ============================================================
+// Allocation-Pattern and GC-Pressure Benchmarks
+//
+// BenchmarkMemoryAllocation1MB/10MB above measure raw make([]byte)
+// cost but say nothing about how pooling changes allocator and GC
+// behavior under repeated use. These benchmarks compare no pooling
+// against sync.Pool and a preallocated ring buffer at several
+// sizes, and report GC deltas alongside ns/op.
+// ============================================================
+
+var poolSizes = []int{4 * 1024, 64 * 1024, 1024 * 1024, 16 * 1024 * 1024}
+
+// reportGCDelta snapshots runtime.MemStats before b.ResetTimer and
+// reports the NumGC/PauseTotalNs delta accumulated over the run.
+func reportGCDelta(b *testing.B, before *runtime.MemStats) {
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.NumGC-before.NumGC), "gc-cycles")
+	b.ReportMetric(float64(after.PauseTotalNs-before.PauseTotalNs)/float64(b.N), "gc-pause-ns/op")
+}
+
+func BenchmarkAllocNoPool(b *testing.B) {
+	for _, size := range poolSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			var before runtime.MemStats
+			runtime.ReadMemStats(&before)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buf := make([]byte, size)
+				buf[0] = 1
+			}
+			reportGCDelta(b, &before)
+		})
+	}
+}
+
+func BenchmarkAllocSyncPool(b *testing.B) {
+	for _, size := range poolSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			pool := sync.Pool{
+				New: func() any {
+					return make([]byte, size)
+				},
+			}
+
+			var before runtime.MemStats
+			runtime.ReadMemStats(&before)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buf := pool.Get().([]byte)
+				buf[0] = 1
+				pool.Put(buf)
+			}
+			reportGCDelta(b, &before)
+		})
+	}
+}
+
+// ringSlots is the number of preallocated buffers the ring reuses
+// before wrapping back to the start.
+const ringSlots = 32
+
+func BenchmarkAllocPreallocatedRing(b *testing.B) {
+	for _, size := range poolSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			ring := make([][]byte, ringSlots)
+			for i := range ring {
+				ring[i] = make([]byte, size)
+			}
+
+			var before runtime.MemStats
+			runtime.ReadMemStats(&before)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buf := ring[i%ringSlots]
+				buf[0] = 1
+			}
+			reportGCDelta(b, &before)
+		})
+	}
+}
+
+// BenchmarkGCPressureMixed allocates short-lived and long-lived
+// objects in a 10:1 ratio, once per b.N iteration, and reports the
+// achieved GC cycles per second under that mix. The workload is
+// driven through b.N like every other benchmark here rather than a
+// fixed wall-clock budget: a fixed-duration custom loop doesn't
+// scale with b.N, so the standard calibration (which keeps raising
+// b.N trying to reach -benchtime) would run away to its iteration
+// cap and report a meaningless near-zero ns/op.
+func BenchmarkGCPressureMixed(b *testing.B) {
+	const shortLivedSize = 4 * 1024
+	const longLivedSize = 64 * 1024
+
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	var long [][]byte
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 10; j++ {
+			buf := make([]byte, shortLivedSize)
+			buf[0] = 1
+		}
+		long = append(long, make([]byte, longLivedSize))
+		if len(long) > 1000 {
+			long = long[len(long)-1000:]
+		}
+	}
+	elapsed := b.Elapsed()
+
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+
+	b.ReportMetric(float64(after.NumGC-before.NumGC)/elapsed.Seconds(), "gc-cycles/sec")
+}
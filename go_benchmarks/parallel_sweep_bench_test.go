@@ -0,0 +1,173 @@
+package benchmark
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// ============================================================
+// Parallel Scalability Sweep
+//
+// BenchmarkMutexContention and BenchmarkRWMutexReadHeavy above run
+// at whatever GOMAXPROCS the harness picks and never vary it, so a
+// primitive that scales poorly past a handful of cores looks
+// identical to one that scales cleanly. These benchmarks sweep
+// b.SetParallelism explicitly and report ops/sec so the runner can
+// plot a scalability curve per primitive.
+// ============================================================
+
+var parallelSweepLevels = []int{1, 2, 4, 8, 16, 32}
+
+func runParallelSweep(b *testing.B, body func(pb *testing.PB)) {
+	for _, p := range parallelSweepLevels {
+		b.Run(parallelismLabel(p), func(b *testing.B) {
+			b.SetParallelism(p)
+			var ops int64
+			b.RunParallel(func(pb *testing.PB) {
+				var local int64
+				for pb.Next() {
+					body(pb)
+					local++
+				}
+				atomic.AddInt64(&ops, local)
+			})
+			b.ReportMetric(float64(ops)/b.Elapsed().Seconds(), "ops/sec")
+		})
+	}
+}
+
+func parallelismLabel(p int) string {
+	switch p {
+	case 1:
+		return "p=1"
+	case 2:
+		return "p=2"
+	case 4:
+		return "p=4"
+	case 8:
+		return "p=8"
+	case 16:
+		return "p=16"
+	default:
+		return "p=32"
+	}
+}
+
+func BenchmarkParallelSweepMutexCounter(b *testing.B) {
+	var mu sync.Mutex
+	var counter int64
+	runParallelSweep(b, func(pb *testing.PB) {
+		mu.Lock()
+		counter++
+		mu.Unlock()
+	})
+}
+
+func BenchmarkParallelSweepRWMutexMapLookup(b *testing.B) {
+	var mu sync.RWMutex
+	data := make(map[int]int, 1000)
+	for i := 0; i < 1000; i++ {
+		data[i] = i
+	}
+	runParallelSweep(b, func(pb *testing.PB) {
+		mu.RLock()
+		_ = data[rand.Intn(1000)]
+		mu.RUnlock()
+	})
+}
+
+func BenchmarkParallelSweepShardedCounter(b *testing.B) {
+	for _, p := range parallelSweepLevels {
+		b.Run(parallelismLabel(p), func(b *testing.B) {
+			shards := make([]atomic.Int64, p)
+			var next int64
+
+			b.SetParallelism(p)
+			var ops int64
+			b.RunParallel(func(pb *testing.PB) {
+				shard := &shards[atomic.AddInt64(&next, 1)%int64(p)]
+				var local int64
+				for pb.Next() {
+					shard.Add(1)
+					local++
+				}
+				atomic.AddInt64(&ops, local)
+			})
+			b.ReportMetric(float64(ops)/b.Elapsed().Seconds(), "ops/sec")
+		})
+	}
+}
+
+func BenchmarkParallelSweepChannelPingPong(b *testing.B) {
+	runParallelSweep(b, func(pb *testing.PB) {
+		ch := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			<-ch
+			close(done)
+		}()
+		ch <- struct{}{}
+		<-done
+	})
+}
+
+func BenchmarkParallelSweepSyncMap(b *testing.B) {
+	var m sync.Map
+	for i := 0; i < 1000; i++ {
+		m.Store(i, i)
+	}
+	runParallelSweep(b, func(pb *testing.PB) {
+		key := rand.Intn(1000)
+		if key%10 == 0 {
+			m.Store(key, key)
+		} else {
+			m.Load(key)
+		}
+	})
+}
+
+// paddedCounter is padded to a full cache line (64 bytes on
+// virtually all current hardware) so that two goroutines writing
+// to adjacent counters never invalidate each other's cache line.
+type paddedCounter struct {
+	value int64
+	_     [56]byte
+}
+
+func BenchmarkFalseSharingPacked(b *testing.B) {
+	procs := 8
+	counters := make([]int64, procs)
+
+	b.ResetTimer()
+	var wg sync.WaitGroup
+	for p := 0; p < procs; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < b.N; i++ {
+				atomic.AddInt64(&counters[p], 1)
+			}
+		}(p)
+	}
+	wg.Wait()
+}
+
+func BenchmarkFalseSharingPadded(b *testing.B) {
+	procs := 8
+	counters := make([]paddedCounter, procs)
+
+	b.ResetTimer()
+	var wg sync.WaitGroup
+	for p := 0; p < procs; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < b.N; i++ {
+				atomic.AddInt64(&counters[p].value, 1)
+			}
+		}(p)
+	}
+	wg.Wait()
+}